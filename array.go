@@ -87,6 +87,12 @@ type UntypedTextArray struct {
 }
 
 func ParseUntypedTextArray(src string) (*UntypedTextArray, error) {
+	return parseUntypedTextArray(src, ',')
+}
+
+// parseUntypedTextArray parses the text format of an array with elements separated by delimiter. The delimiter is ','
+// for all built-in types except box which uses ';'.
+func parseUntypedTextArray(src string, delimiter rune) (*UntypedTextArray, error) {
 	dst := &UntypedTextArray{}
 
 	buf := bytes.NewBufferString(src)
@@ -189,7 +195,7 @@ func ParseUntypedTextArray(src string) (*UntypedTextArray, error) {
 				implicitDimensions[currentDim].Length++
 			}
 			currentDim++
-		case ',':
+		case delimiter:
 		case '}':
 			currentDim--
 			if currentDim < counterDim {
@@ -197,7 +203,7 @@ func ParseUntypedTextArray(src string) (*UntypedTextArray, error) {
 			}
 		default:
 			buf.UnreadRune()
-			value, quoted, err := arrayParseValue(buf)
+			value, quoted, err := arrayParseValue(buf, delimiter)
 			if err != nil {
 				return nil, fmt.Errorf("invalid array value: %v", err)
 			}
@@ -241,7 +247,7 @@ func skipWhitespace(buf *bytes.Buffer) {
 	}
 }
 
-func arrayParseValue(buf *bytes.Buffer) (string, bool, error) {
+func arrayParseValue(buf *bytes.Buffer, delimiter rune) (string, bool, error) {
 	r, _, err := buf.ReadRune()
 	if err != nil {
 		return "", false, err
@@ -260,7 +266,7 @@ func arrayParseValue(buf *bytes.Buffer) (string, bool, error) {
 		}
 
 		switch r {
-		case ',', '}':
+		case delimiter, '}':
 			buf.UnreadRune()
 			return s.String(), false, nil
 		}
@@ -353,7 +359,12 @@ func isSpace(ch byte) bool {
 }
 
 func QuoteArrayElementIfNeeded(src string) string {
-	if src == "" || (len(src) == 4 && strings.ToLower(src) == "null") || isSpace(src[0]) || isSpace(src[len(src)-1]) || strings.ContainsAny(src, `{},"\`) {
+	return quoteArrayElementIfNeeded(src, ',')
+}
+
+// quoteArrayElementIfNeeded quotes src if it is needed in an array with elements separated by delimiter.
+func quoteArrayElementIfNeeded(src string, delimiter byte) string {
+	if src == "" || (len(src) == 4 && strings.ToLower(src) == "null") || isSpace(src[0]) || isSpace(src[len(src)-1]) || strings.ContainsAny(src, `{}"\`) || strings.IndexByte(src, delimiter) != -1 {
 		return quoteArrayElement(src)
 	}
 	return src
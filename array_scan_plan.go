@@ -0,0 +1,365 @@
+package pgtype
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// binaryArrayElements iterates over the elements of a one dimensional binary encoded array without allocating.
+type binaryArrayElements struct {
+	src   []byte
+	rp    int
+	count int
+}
+
+// newBinaryArrayElements prepares to iterate over the elements of the binary encoded array src. ok is false if the
+// array has more than one dimension.
+func newBinaryArrayElements(src []byte) (elements binaryArrayElements, ok bool, err error) {
+	if len(src) < 12 {
+		return binaryArrayElements{}, false, fmt.Errorf("array header too short: %d", len(src))
+	}
+
+	numDims := int32(binary.BigEndian.Uint32(src))
+	switch numDims {
+	case 0:
+		return binaryArrayElements{src: src, rp: 12}, true, nil
+	case 1:
+	default:
+		return binaryArrayElements{}, false, nil
+	}
+
+	if len(src) < 20 {
+		return binaryArrayElements{}, false, fmt.Errorf("array header too short for %d dimensions: %d", numDims, len(src))
+	}
+
+	count := int32(binary.BigEndian.Uint32(src[12:]))
+	if count < 0 {
+		return binaryArrayElements{}, false, fmt.Errorf("invalid array dimension length: %d", count)
+	}
+
+	// Every element has at least a 4 byte length. Check before the caller allocates for count elements.
+	if int64(count)*4 > int64(len(src)-20) {
+		return binaryArrayElements{}, false, fmt.Errorf("array too short for %d elements: %d", count, len(src))
+	}
+
+	return binaryArrayElements{src: src, rp: 20, count: int(count)}, true, nil
+}
+
+// next returns the next element. It returns nil for a NULL element.
+func (e *binaryArrayElements) next() ([]byte, error) {
+	if len(e.src)-e.rp < 4 {
+		return nil, fmt.Errorf("array element length missing")
+	}
+
+	elemLen := int(int32(binary.BigEndian.Uint32(e.src[e.rp:])))
+	e.rp += 4
+
+	if elemLen < 0 {
+		return nil, nil
+	}
+
+	if len(e.src)-e.rp < elemLen {
+		return nil, fmt.Errorf("array element too short: %d", len(e.src)-e.rp)
+	}
+
+	elemSrc := e.src[e.rp : e.rp+elemLen]
+	e.rp += elemLen
+	return elemSrc, nil
+}
+
+// scanBinaryArray scans the binary encoded array src into the slice pointed to by dst. setLen is called to allocate the
+// slice with the number of elements. decode is called with each non-NULL element. elementLen is the required length in
+// bytes of each element or -1 if elements have a variable length. NULL elements are left as the zero value when
+// nullable is true and are an error otherwise. Arrays that can not be scanned directly, such as multi-dimensional
+// arrays, are scanned with the data type registered for oid.
+func scanBinaryArray(ci *ConnInfo, oid uint32, src []byte, dst interface{}, elementName string, elementLen int, nullable bool, setLen func(n int), decode func(i int, elemSrc []byte) error) error {
+	if src == nil {
+		sliceVal := reflect.ValueOf(dst).Elem()
+		sliceVal.Set(reflect.Zero(sliceVal.Type()))
+		return nil
+	}
+
+	elements, ok, err := newBinaryArrayElements(src)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return scanBinaryArrayFallback(ci, oid, src, dst)
+	}
+
+	setLen(elements.count)
+	for i := 0; i < elements.count; i++ {
+		elemSrc, err := elements.next()
+		if err != nil {
+			return err
+		}
+
+		if elemSrc == nil {
+			if !nullable {
+				return fmt.Errorf("cannot scan NULL array element into %T", dst)
+			}
+			continue
+		}
+
+		if elementLen >= 0 && len(elemSrc) != elementLen {
+			return fmt.Errorf("invalid length for %s: %v", elementName, len(elemSrc))
+		}
+
+		err = decode(i, elemSrc)
+		if err != nil {
+			return err
+		}
+	}
+
+	if elements.rp != len(src) {
+		return fmt.Errorf("array has %d unexpected trailing bytes", len(src)-elements.rp)
+	}
+
+	return nil
+}
+
+// scanBinaryArrayFallback scans src with the data type registered for oid.
+func scanBinaryArrayFallback(ci *ConnInfo, oid uint32, src []byte, dst interface{}) error {
+	dt, ok := ci.DataTypeForOID(oid)
+	if !ok {
+		return fmt.Errorf("unable to scan oid %d into %T", oid, dst)
+	}
+
+	value := NewValue(dt.Value)
+	decoder, ok := value.(BinaryDecoder)
+	if !ok {
+		return fmt.Errorf("unable to decode binary for oid %d", oid)
+	}
+
+	err := decoder.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	return value.AssignTo(dst)
+}
+
+type scanPlanBinaryInt16Array struct{}
+
+func (scanPlanBinaryInt16Array) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]int16:
+		return scanBinaryArray(ci, oid, src, dst, "int2", 2, false, func(n int) { *p = make([]int16, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = int16(binary.BigEndian.Uint16(elemSrc))
+			return nil
+		})
+	case *[]*int16:
+		return scanBinaryArray(ci, oid, src, dst, "int2", 2, true, func(n int) { *p = make([]*int16, n) }, func(i int, elemSrc []byte) error {
+			v := int16(binary.BigEndian.Uint16(elemSrc))
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryInt32Array struct{}
+
+func (scanPlanBinaryInt32Array) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]int32:
+		return scanBinaryArray(ci, oid, src, dst, "int4", 4, false, func(n int) { *p = make([]int32, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = int32(binary.BigEndian.Uint32(elemSrc))
+			return nil
+		})
+	case *[]*int32:
+		return scanBinaryArray(ci, oid, src, dst, "int4", 4, true, func(n int) { *p = make([]*int32, n) }, func(i int, elemSrc []byte) error {
+			v := int32(binary.BigEndian.Uint32(elemSrc))
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryInt64Array struct{}
+
+func (scanPlanBinaryInt64Array) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]int64:
+		return scanBinaryArray(ci, oid, src, dst, "int8", 8, false, func(n int) { *p = make([]int64, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = int64(binary.BigEndian.Uint64(elemSrc))
+			return nil
+		})
+	case *[]*int64:
+		return scanBinaryArray(ci, oid, src, dst, "int8", 8, true, func(n int) { *p = make([]*int64, n) }, func(i int, elemSrc []byte) error {
+			v := int64(binary.BigEndian.Uint64(elemSrc))
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryFloat32Array struct{}
+
+func (scanPlanBinaryFloat32Array) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]float32:
+		return scanBinaryArray(ci, oid, src, dst, "float4", 4, false, func(n int) { *p = make([]float32, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = math.Float32frombits(binary.BigEndian.Uint32(elemSrc))
+			return nil
+		})
+	case *[]*float32:
+		return scanBinaryArray(ci, oid, src, dst, "float4", 4, true, func(n int) { *p = make([]*float32, n) }, func(i int, elemSrc []byte) error {
+			v := math.Float32frombits(binary.BigEndian.Uint32(elemSrc))
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryFloat64Array struct{}
+
+func (scanPlanBinaryFloat64Array) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]float64:
+		return scanBinaryArray(ci, oid, src, dst, "float8", 8, false, func(n int) { *p = make([]float64, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = math.Float64frombits(binary.BigEndian.Uint64(elemSrc))
+			return nil
+		})
+	case *[]*float64:
+		return scanBinaryArray(ci, oid, src, dst, "float8", 8, true, func(n int) { *p = make([]*float64, n) }, func(i int, elemSrc []byte) error {
+			v := math.Float64frombits(binary.BigEndian.Uint64(elemSrc))
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryBoolArray struct{}
+
+func (scanPlanBinaryBoolArray) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]bool:
+		return scanBinaryArray(ci, oid, src, dst, "bool", 1, false, func(n int) { *p = make([]bool, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = elemSrc[0] == 1
+			return nil
+		})
+	case *[]*bool:
+		return scanBinaryArray(ci, oid, src, dst, "bool", 1, true, func(n int) { *p = make([]*bool, n) }, func(i int, elemSrc []byte) error {
+			v := elemSrc[0] == 1
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryTextArray struct{}
+
+func (scanPlanBinaryTextArray) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]string:
+		return scanBinaryArray(ci, oid, src, dst, "text", -1, false, func(n int) { *p = make([]string, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = string(elemSrc)
+			return nil
+		})
+	case *[]*string:
+		return scanBinaryArray(ci, oid, src, dst, "text", -1, true, func(n int) { *p = make([]*string, n) }, func(i int, elemSrc []byte) error {
+			v := string(elemSrc)
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+type scanPlanBinaryUUIDArray struct{}
+
+func (scanPlanBinaryUUIDArray) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[][16]byte:
+		return scanBinaryArray(ci, oid, src, dst, "uuid", 16, false, func(n int) { *p = make([][16]byte, n) }, func(i int, elemSrc []byte) error {
+			copy((*p)[i][:], elemSrc)
+			return nil
+		})
+	case *[]*[16]byte:
+		return scanBinaryArray(ci, oid, src, dst, "uuid", 16, true, func(n int) { *p = make([]*[16]byte, n) }, func(i int, elemSrc []byte) error {
+			var v [16]byte
+			copy(v[:], elemSrc)
+			(*p)[i] = &v
+			return nil
+		})
+	case *[]string:
+		return scanBinaryArray(ci, oid, src, dst, "uuid", 16, false, func(n int) { *p = make([]string, n) }, func(i int, elemSrc []byte) error {
+			(*p)[i] = encodeUUIDBytes(elemSrc)
+			return nil
+		})
+	case *[]*string:
+		return scanBinaryArray(ci, oid, src, dst, "uuid", 16, true, func(n int) { *p = make([]*string, n) }, func(i int, elemSrc []byte) error {
+			v := encodeUUIDBytes(elemSrc)
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+func encodeUUIDBytes(src []byte) string {
+	var uuid [16]byte
+	copy(uuid[:], src)
+	return encodeUUID(uuid)
+}
+
+type scanPlanBinaryTimestamptzArray struct{}
+
+func (scanPlanBinaryTimestamptzArray) Scan(ci *ConnInfo, oid uint32, formatCode int16, src []byte, dst interface{}) error {
+	switch p := dst.(type) {
+	case *[]time.Time:
+		return scanBinaryArray(ci, oid, src, dst, "timestamptz", 8, false, func(n int) { *p = make([]time.Time, n) }, func(i int, elemSrc []byte) (err error) {
+			(*p)[i], err = decodeBinaryTimestamptzTime(elemSrc)
+			return err
+		})
+	case *[]*time.Time:
+		return scanBinaryArray(ci, oid, src, dst, "timestamptz", 8, true, func(n int) { *p = make([]*time.Time, n) }, func(i int, elemSrc []byte) error {
+			v, err := decodeBinaryTimestamptzTime(elemSrc)
+			if err != nil {
+				return err
+			}
+			(*p)[i] = &v
+			return nil
+		})
+	}
+
+	newPlan := ci.PlanScan(oid, formatCode, dst)
+	return newPlan.Scan(ci, oid, formatCode, src, dst)
+}
+
+// decodeBinaryTimestamptzTime decodes the 8 byte binary timestamptz src into a time.Time.
+func decodeBinaryTimestamptzTime(src []byte) (time.Time, error) {
+	microsecSinceY2K := int64(binary.BigEndian.Uint64(src))
+	switch microsecSinceY2K {
+	case infinityMicrosecondOffset, negativeInfinityMicrosecondOffset:
+		return time.Time{}, fmt.Errorf("cannot scan infinite timestamptz into time.Time")
+	}
+
+	microsecSinceUnixEpoch := microsecFromUnixEpochToY2K + microsecSinceY2K
+	return time.Unix(microsecSinceUnixEpoch/1000000, (microsecSinceUnixEpoch%1000000)*1000), nil
+}
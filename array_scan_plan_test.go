@@ -0,0 +1,184 @@
+package pgtype_test
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func mustEncodeBinary(t testing.TB, ci *pgtype.ConnInfo, value pgtype.BinaryEncoder) []byte {
+	buf, err := value.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	return buf
+}
+
+func TestConnInfoScanBinaryArraysIntoSlices(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 8000, time.Local)
+	uuid := [16]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+	tests := []struct {
+		oid      uint32
+		src      pgtype.Value
+		dst      interface{}
+		expected interface{}
+	}{
+		{pgtype.Int2ArrayOID, &pgtype.Int2Array{}, &[]int16{}, []int16{1, -2}},
+		{pgtype.Int4ArrayOID, &pgtype.Int4Array{}, &[]int32{}, []int32{1, -2}},
+		{pgtype.Int8ArrayOID, &pgtype.Int8Array{}, &[]int64{}, []int64{1, -2}},
+		{pgtype.Float4ArrayOID, &pgtype.Float4Array{}, &[]float32{}, []float32{1.5, -2}},
+		{pgtype.Float8ArrayOID, &pgtype.Float8Array{}, &[]float64{}, []float64{1.5, -2}},
+		{pgtype.BoolArrayOID, &pgtype.BoolArray{}, &[]bool{}, []bool{true, false}},
+		{pgtype.TextArrayOID, &pgtype.TextArray{}, &[]string{}, []string{"foo", ""}},
+		{pgtype.VarcharArrayOID, &pgtype.VarcharArray{}, &[]string{}, []string{"foo", ""}},
+		{pgtype.UUIDArrayOID, &pgtype.UUIDArray{}, &[][16]byte{}, [][16]byte{uuid}},
+		{pgtype.UUIDArrayOID, &pgtype.UUIDArray{}, &[]string{}, []string{"00010203-0405-0607-0809-0a0b0c0d0e0f"}},
+		{pgtype.TimestamptzArrayOID, &pgtype.TimestamptzArray{}, &[]time.Time{}, []time.Time{ts}},
+	}
+
+	for i, tt := range tests {
+		require.NoErrorf(t, tt.src.Set(tt.expected), "%d", i)
+		src := mustEncodeBinary(t, ci, tt.src.(pgtype.BinaryEncoder))
+
+		err := ci.Scan(tt.oid, pgtype.BinaryFormatCode, src, tt.dst)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.expected, reflect.ValueOf(tt.dst).Elem().Interface(), "%d", i)
+	}
+}
+
+func TestConnInfoScanBinaryArrayNulls(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	src := mustEncodeBinary(t, ci, &pgtype.Int4Array{
+		Elements:   []pgtype.Int4{{Int: 1, Status: pgtype.Present}, {Status: pgtype.Null}},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	})
+
+	var ints []int32
+	err := ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &ints)
+	require.EqualError(t, err, "cannot scan NULL array element into *[]int32")
+
+	var intPtrs []*int32
+	err = ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &intPtrs)
+	require.NoError(t, err)
+	require.Len(t, intPtrs, 2)
+	require.EqualValues(t, 1, *intPtrs[0])
+	require.Nil(t, intPtrs[1])
+
+	ints = []int32{1}
+	err = ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, nil, &ints)
+	require.NoError(t, err)
+	require.Nil(t, ints)
+
+	src = mustEncodeBinary(t, ci, &pgtype.Int4Array{Status: pgtype.Present})
+	err = ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &ints)
+	require.NoError(t, err)
+	require.NotNil(t, ints)
+	require.Empty(t, ints)
+}
+
+func TestConnInfoScanBinaryArrayMultipleDimensions(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	src := mustEncodeBinary(t, ci, &pgtype.Int4Array{
+		Elements: []pgtype.Int4{
+			{Int: 1, Status: pgtype.Present},
+			{Int: 2, Status: pgtype.Present},
+			{Int: 3, Status: pgtype.Present},
+			{Int: 4, Status: pgtype.Present},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}, {Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	})
+
+	var ints []int32
+	err := ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &ints)
+	require.EqualError(t, err, "incorrect dimensions, expected 2, found 1")
+
+	var nestedInts [][]int32
+	err = ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &nestedInts)
+	require.NoError(t, err)
+	require.Equal(t, [][]int32{{1, 2}, {3, 4}}, nestedInts)
+}
+
+func TestConnInfoScanBinaryArrayInvalid(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	src := mustEncodeBinary(t, ci, &pgtype.Int4Array{
+		Elements:   []pgtype.Int4{{Int: 1, Status: pgtype.Present}},
+		Dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}},
+		Status:     pgtype.Present,
+	})
+
+	var ints []int32
+	for _, truncated := range [][]byte{src[:8], src[:16], src[:len(src)-1]} {
+		err := ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, truncated, &ints)
+		require.Error(t, err)
+	}
+
+	var int64s []int64
+	err := ci.Scan(pgtype.Int8ArrayOID, pgtype.BinaryFormatCode, src, &int64s)
+	require.Error(t, err)
+
+	// A header claiming more elements than the payload can hold is rejected before allocating.
+	huge := append([]byte{}, src[:20]...)
+	binary.BigEndian.PutUint32(huge[12:], math.MaxInt32)
+	err = ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, huge, &ints)
+	require.EqualError(t, err, "array too short for 2147483647 elements: 20")
+
+	err = ci.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, append(src, 0), &ints)
+	require.EqualError(t, err, "array has 1 unexpected trailing bytes")
+}
+
+func TestScanPlanBinaryInt32ArrayScanChangedType(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	src := mustEncodeBinary(t, ci, &pgtype.Int4Array{
+		Elements:   []pgtype.Int4{{Int: 42, Status: pgtype.Present}},
+		Dimensions: []pgtype.ArrayDimension{{Length: 1, LowerBound: 1}},
+		Status:     pgtype.Present,
+	})
+
+	var ints []int32
+	plan := ci.PlanScan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, &ints)
+	err := plan.Scan(ci, pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &ints)
+	require.NoError(t, err)
+	require.Equal(t, []int32{42}, ints)
+
+	var int64s []int64
+	err = plan.Scan(ci, pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &int64s)
+	require.NoError(t, err)
+	require.Equal(t, []int64{42}, int64s)
+}
+
+func BenchmarkScanPlanScanInt4ArrayIntoGoInt32Slice(b *testing.B) {
+	ci := pgtype.NewConnInfo()
+	src := mustEncodeBinary(b, ci, &pgtype.Int4Array{
+		Elements: []pgtype.Int4{
+			{Int: 1, Status: pgtype.Present},
+			{Int: 2, Status: pgtype.Present},
+			{Int: 3, Status: pgtype.Present},
+			{Int: 4, Status: pgtype.Present},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 4, LowerBound: 1}},
+		Status:     pgtype.Present,
+	})
+	var v []int32
+
+	plan := ci.PlanScan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, &v)
+
+	for i := 0; i < b.N; i++ {
+		err := plan.Scan(ci, pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, src, &v)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(v) != 4 {
+			b.Fatal("scan failed due to bad value")
+		}
+	}
+}
@@ -43,6 +43,11 @@ func (at *ArrayType) TypeName() string {
 	return at.typeName
 }
 
+// ElementOID returns the OID of the array element type.
+func (at *ArrayType) ElementOID() uint32 {
+	return at.elementOID
+}
+
 func (dst *ArrayType) setNil() {
 	dst.elements = nil
 	dst.dimensions = nil
@@ -97,6 +102,10 @@ func (dst ArrayType) Get() interface{} {
 	}
 }
 
+// AssignTo assigns src to dst. dst must be a pointer to a slice or array. Multidimensional arrays can be assigned to
+// nested slices or arrays. A multidimensional array assigned to a flat slice is flattened. Each element is assigned with
+// the AssignTo method of the element type. e.g. When the elements are a CompositeType, dst may be a pointer to a slice
+// of structs.
 func (src *ArrayType) AssignTo(dst interface{}) error {
 	ptrSlice := reflect.ValueOf(dst)
 	if ptrSlice.Kind() != reflect.Ptr {
@@ -106,26 +115,38 @@ func (src *ArrayType) AssignTo(dst interface{}) error {
 	sliceVal := ptrSlice.Elem()
 	sliceType := sliceVal.Type()
 
-	if sliceType.Kind() != reflect.Slice {
+	if sliceType.Kind() != reflect.Slice && sliceType.Kind() != reflect.Array {
 		return fmt.Errorf("cannot assign to pointer to non-slice")
 	}
 
 	switch src.status {
 	case Present:
-		slice := reflect.MakeSlice(sliceType, len(src.elements), len(src.elements))
-		elemType := sliceType.Elem()
+		if len(src.dimensions) == 0 {
+			if sliceType.Kind() == reflect.Slice {
+				sliceVal.Set(reflect.MakeSlice(sliceType, 0, 0))
+				return nil
+			}
+			if sliceType.Len() != 0 {
+				return fmt.Errorf("expected size 0 array, but %s has size %d array", sliceType, sliceType.Len())
+			}
+			sliceVal.Set(reflect.Zero(sliceType))
+			return nil
+		}
 
-		for i := range src.elements {
-			ptrElem := reflect.New(elemType)
-			err := src.elements[i].AssignTo(ptrElem.Interface())
-			if err != nil {
-				return err
+		if len(src.dimensions) > 1 && sliceType.Kind() == reflect.Slice {
+			if elemKind := sliceType.Elem().Kind(); elemKind != reflect.Slice && elemKind != reflect.Array {
+				return src.assignToFlat(sliceVal)
 			}
+		}
 
-			slice.Index(i).Set(ptrElem.Elem())
+		elementCount, err := src.assignToRecursive(sliceVal, 0, 0)
+		if err != nil {
+			return err
+		}
+		if elementCount != len(src.elements) {
+			return fmt.Errorf("cannot assign %v, needed to assign %d elements, but only assigned %d", dst, len(src.elements), elementCount)
 		}
 
-		sliceVal.Set(slice)
 		return nil
 	case Null:
 		sliceVal.Set(reflect.Zero(sliceType))
@@ -135,6 +156,72 @@ func (src *ArrayType) AssignTo(dst interface{}) error {
 	return fmt.Errorf("cannot decode %#v into %T", src, dst)
 }
 
+// assignToFlat assigns all elements of src in order to the slice sliceVal regardless of the dimensions of src.
+func (src *ArrayType) assignToFlat(sliceVal reflect.Value) error {
+	sliceType := sliceVal.Type()
+	slice := reflect.MakeSlice(sliceType, len(src.elements), len(src.elements))
+	elemType := sliceType.Elem()
+
+	for i := range src.elements {
+		ptrElem := reflect.New(elemType)
+		err := src.elements[i].AssignTo(ptrElem.Interface())
+		if err != nil {
+			return err
+		}
+
+		slice.Index(i).Set(ptrElem.Elem())
+	}
+
+	sliceVal.Set(slice)
+	return nil
+}
+
+func (src *ArrayType) assignToRecursive(value reflect.Value, index, dimension int) (int, error) {
+	switch kind := value.Kind(); kind {
+	case reflect.Array, reflect.Slice:
+		if len(src.dimensions) == dimension {
+			break
+		}
+
+		length := int(src.dimensions[dimension].Length)
+		if kind == reflect.Array {
+			typ := value.Type()
+			if typ.Len() != length {
+				return 0, fmt.Errorf("expected size %d array, but %s has size %d array", length, typ, typ.Len())
+			}
+			value.Set(reflect.New(typ).Elem())
+		} else {
+			value.Set(reflect.MakeSlice(value.Type(), length, length))
+		}
+
+		var err error
+		for i := 0; i < length; i++ {
+			index, err = src.assignToRecursive(value.Index(i), index, dimension+1)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		return index, nil
+	}
+
+	if len(src.dimensions) != dimension {
+		return 0, fmt.Errorf("incorrect dimensions, expected %d, found %d", len(src.dimensions), dimension)
+	}
+	if !value.CanAddr() {
+		return 0, fmt.Errorf("cannot assign all values from ArrayType")
+	}
+	if index >= len(src.elements) {
+		return 0, fmt.Errorf("cannot assign all values from ArrayType, expected at least %d elements, found %d", index+1, len(src.elements))
+	}
+	if err := src.elements[index].AssignTo(value.Addr().Interface()); err != nil {
+		return 0, err
+	}
+	index++
+
+	return index, nil
+}
+
 func (dst *ArrayType) DecodeText(ci *ConnInfo, src []byte) error {
 	if src == nil {
 		dst.setNil()
@@ -82,3 +82,126 @@ func TestArrayTypeEmptyArrayDoesNotBreakArrayType(t *testing.T) {
 
 	require.EqualValues(t, []string{"red", "green", "blue"}, dstStrings)
 }
+
+func TestArrayTypeAssignToStructs(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	ct, err := pgtype.NewCompositeType("point3d", []pgtype.CompositeTypeField{
+		{Name: "x", OID: pgtype.Int4OID},
+		{Name: "label", OID: pgtype.TextOID},
+	}, ci)
+	require.NoError(t, err)
+	ci.RegisterDataType(pgtype.DataType{Value: ct, Name: "point3d", OID: 200000})
+	require.NoError(t, ci.RegisterArrayType(200001, 200000))
+
+	dt, ok := ci.DataTypeForOID(200001)
+	require.True(t, ok)
+	require.Equal(t, "_point3d", dt.Name)
+
+	type point3d struct {
+		X     int32
+		Label string
+	}
+
+	arrayType := pgtype.NewValue(dt.Value).(*pgtype.ArrayType)
+
+	err = arrayType.DecodeText(ci, []byte(`{"(1,foo)","(2,bar)"}`))
+	require.NoError(t, err)
+
+	var points []point3d
+	err = arrayType.AssignTo(&points)
+	require.NoError(t, err)
+	require.Equal(t, []point3d{{X: 1, Label: "foo"}, {X: 2, Label: "bar"}}, points)
+
+	err = arrayType.DecodeText(ci, []byte(`{{"(1,foo)","(2,bar)"},{"(3,baz)",NULL}}`))
+	require.NoError(t, err)
+
+	var pointPtrs [][]*point3d
+	err = arrayType.AssignTo(&pointPtrs)
+	require.NoError(t, err)
+	require.Equal(t, [][]*point3d{{{X: 1, Label: "foo"}, {X: 2, Label: "bar"}}, {{X: 3, Label: "baz"}, nil}}, pointPtrs)
+
+	var pointArrays [2][2]*point3d
+	err = arrayType.AssignTo(&pointArrays)
+	require.NoError(t, err)
+	require.Equal(t, [2][2]*point3d{{{X: 1, Label: "foo"}, {X: 2, Label: "bar"}}, {{X: 3, Label: "baz"}, nil}}, pointArrays)
+
+	// A multidimensional array is flattened when assigned to a flat slice.
+	var flatPointPtrs []*point3d
+	err = arrayType.AssignTo(&flatPointPtrs)
+	require.NoError(t, err)
+	require.Equal(t, []*point3d{{X: 1, Label: "foo"}, {X: 2, Label: "bar"}, {X: 3, Label: "baz"}, nil}, flatPointPtrs)
+
+	var pointArray [4]*point3d
+	err = arrayType.AssignTo(&pointArray)
+	require.Error(t, err)
+}
+
+func TestArrayTypeAssignEmptyToGoArray(t *testing.T) {
+	arrayType := pgtype.NewArrayType("_int4", pgtype.Int4OID, func() pgtype.ValueTranscoder { return &pgtype.Int4{} })
+
+	err := arrayType.DecodeText(nil, []byte("{}"))
+	require.NoError(t, err)
+
+	var empty [0]int32
+	err = arrayType.AssignTo(&empty)
+	require.NoError(t, err)
+
+	pair := [2]int32{1, 2}
+	err = arrayType.AssignTo(&pair)
+	require.Error(t, err)
+	require.Equal(t, [2]int32{1, 2}, pair)
+}
+
+func TestConnInfoRegisterArrayTypeName(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for i, name := range []string{"mood", "myschema.mood", `"My Mood"`, `"my.schema"."My Mood"`} {
+		elementOID := uint32(200000 + i*2)
+		ci.RegisterDataType(pgtype.DataType{Value: pgtype.NewEnumType(name, []string{"happy", "sad"}), Name: name, OID: elementOID})
+		require.NoError(t, ci.RegisterArrayType(elementOID+1, elementOID))
+	}
+
+	for _, name := range []string{"_mood", "myschema._mood", `"_My Mood"`, `"my.schema"."_My Mood"`} {
+		_, ok := ci.DataTypeForName(name)
+		require.Truef(t, ok, "%s not registered", name)
+	}
+
+	err := ci.RegisterArrayType(300001, 300000)
+	require.Error(t, err)
+}
+
+func TestArrayTypeOfCompositeTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `drop type if exists at_ct_test;
+
+create type at_ct_test as (
+	a text,
+	b int4
+);`)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), "drop type at_ct_test")
+
+	var oid, arrayOID uint32
+	err = conn.QueryRow(context.Background(), "select oid, typarray from pg_type where typname='at_ct_test'").Scan(&oid, &arrayOID)
+	require.NoError(t, err)
+
+	ct, err := pgtype.NewCompositeType("at_ct_test", []pgtype.CompositeTypeField{
+		{Name: "a", OID: pgtype.TextOID},
+		{Name: "b", OID: pgtype.Int4OID},
+	}, conn.ConnInfo())
+	require.NoError(t, err)
+	conn.ConnInfo().RegisterDataType(pgtype.DataType{Value: ct, Name: "at_ct_test", OID: oid})
+	require.NoError(t, conn.ConnInfo().RegisterArrayType(arrayOID, oid))
+
+	type ctTest struct {
+		A string
+		B int32
+	}
+
+	var dst []ctTest
+	err = conn.QueryRow(context.Background(), "select array[row('foo', 1), row('bar', 2)]::at_ct_test[]").Scan(&dst)
+	require.NoError(t, err)
+	require.Equal(t, []ctTest{{A: "foo", B: 1}, {A: "bar", B: 2}}, dst)
+}
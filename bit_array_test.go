@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestBitArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "bit(8)[]", []interface{}{
+		&pgtype.BitArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.BitArray{
+			Elements: []pgtype.Bit{
+				{Bytes: []byte{0}, Len: 8, Status: pgtype.Present},
+				{Bytes: []byte{254}, Len: 8, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.BitArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,56 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoxArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "box[]", []interface{}{
+		&pgtype.BoxArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.BoxArray{
+			Elements: []pgtype.Box{
+				{P: [2]pgtype.Vec2{{7.1, 5.2345678}, {3.14, 1.678}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+				{P: [2]pgtype.Vec2{{7.1, 1.678}, {-13.14, -5.234}}, Status: pgtype.Present},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.BoxArray{Status: pgtype.Null},
+	})
+}
+
+func TestBoxArrayText(t *testing.T) {
+	src := pgtype.BoxArray{
+		Elements: []pgtype.Box{
+			{P: [2]pgtype.Vec2{{3, 4}, {1, 2}}, Status: pgtype.Present},
+			{Status: pgtype.Null},
+			{P: [2]pgtype.Vec2{{7, 8}, {5, 6}}, Status: pgtype.Present},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}
+
+	buf, err := src.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "{(3,4),(1,2);NULL;(7,8),(5,6)}", string(buf))
+
+	var dst pgtype.BoxArray
+	require.NoError(t, dst.DecodeText(nil, buf))
+	require.Equal(t, src, dst)
+
+	// Quoted elements are also accepted.
+	require.NoError(t, dst.DecodeText(nil, []byte(`{"(3,4),(1,2)";NULL;"(7,8),(5,6)"}`)))
+	require.Equal(t, src, dst)
+
+	// A ',' is not a delimiter in a box array.
+	require.Error(t, dst.DecodeText(nil, []byte(`{"(3,4),(1,2)","(7,8),(5,6)"}`)))
+}
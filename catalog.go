@@ -0,0 +1,266 @@
+package pgtype
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// CatalogVersion is the version of the Catalog format produced by ConnInfo.Catalog.
+const CatalogVersion = 1
+
+// Catalog kinds.
+const (
+	CatalogKindEnum      = "enum"
+	CatalogKindComposite = "composite"
+	CatalogKindArray     = "array"
+	CatalogKindRange     = "range"
+	CatalogKindDomain    = "domain"
+	CatalogKindBase      = "base"
+)
+
+// Catalog is a snapshot of the custom data types registered on a ConnInfo. It contains everything needed to rebuild the
+// data types without a database connection and is designed to be serialized with encoding/json. This allows the types
+// loaded by introspecting a database once to be cached and reused.
+type Catalog struct {
+	Version int           `json:"version"`
+	Types   []CatalogType `json:"types"`
+}
+
+// CatalogType describes a single data type in a Catalog. Which fields are used depends on Kind.
+type CatalogType struct {
+	Name string `json:"name"`
+	OID  uint32 `json:"oid"`
+	Kind string `json:"kind"`
+
+	// Members are the members of an enum type.
+	Members []string `json:"members,omitempty"`
+
+	// Fields are the fields of a composite type.
+	Fields []CatalogField `json:"fields,omitempty"`
+
+	// ElementOID is the OID of the element type of an array type or the subtype of a range type.
+	ElementOID uint32 `json:"element_oid,omitempty"`
+
+	// BaseOID is the OID of the base type of a domain type.
+	BaseOID uint32 `json:"base_oid,omitempty"`
+
+	// Value is the name of the Go type in this package used for a base type. e.g. Hstore or GenericText.
+	Value string `json:"value,omitempty"`
+}
+
+// CatalogField is a field of a composite type in a Catalog.
+type CatalogField struct {
+	Name string `json:"name"`
+	OID  uint32 `json:"oid"`
+}
+
+// Catalog returns a snapshot of the data types registered on ci that are not registered by NewConnInfo. The types are
+// ordered by OID.
+//
+// The DomainType validators can not be serialized and are not included in the snapshot.
+func (ci *ConnInfo) Catalog() (*Catalog, error) {
+	defaultConnInfo := NewConnInfo()
+
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+
+	c := &Catalog{Version: CatalogVersion, Types: []CatalogType{}}
+	for oid, dt := range ci.oidToDataType {
+		if defaultDT, ok := defaultConnInfo.oidToDataType[oid]; ok && defaultDT.Name == dt.Name {
+			continue
+		}
+
+		t, err := newCatalogType(dt)
+		if err != nil {
+			return nil, err
+		}
+		c.Types = append(c.Types, t)
+	}
+
+	sort.Slice(c.Types, func(i, j int) bool { return c.Types[i].OID < c.Types[j].OID })
+
+	return c, nil
+}
+
+func newCatalogType(dt *DataType) (CatalogType, error) {
+	t := CatalogType{Name: dt.Name, OID: dt.OID}
+
+	switch v := dt.Value.(type) {
+	case *EnumType:
+		t.Kind = CatalogKindEnum
+		t.Members = v.Members()
+	case *CompositeType:
+		t.Kind = CatalogKindComposite
+		fields := v.Fields()
+		t.Fields = make([]CatalogField, len(fields))
+		for i, f := range fields {
+			t.Fields[i] = CatalogField{Name: f.Name, OID: f.OID}
+		}
+	case *ArrayType:
+		t.Kind = CatalogKindArray
+		t.ElementOID = v.ElementOID()
+	case *RangeType:
+		t.Kind = CatalogKindRange
+		t.ElementOID = v.ElementOID()
+	case *DomainType:
+		t.Kind = CatalogKindDomain
+		t.BaseOID = v.BaseOID()
+	default:
+		name := reflect.TypeOf(dt.Value).Elem().Name()
+		if _, ok := catalogBaseValues()[name]; !ok {
+			return CatalogType{}, fmt.Errorf("cannot add data type %s to catalog: unsupported value %T", dt.Name, dt.Value)
+		}
+		t.Kind = CatalogKindBase
+		t.Value = name
+	}
+
+	return t, nil
+}
+
+// catalogBaseValues returns the Values that can be used for base types in a Catalog by Go type name.
+func catalogBaseValues() map[string]Value {
+	values := make(map[string]Value, len(nameValues)+2)
+	for _, v := range nameValues {
+		values[reflect.TypeOf(v).Elem().Name()] = v
+	}
+	values["GenericText"] = &GenericText{}
+	values["GenericBinary"] = &GenericBinary{}
+
+	return values
+}
+
+// RegisterCatalog registers the data types in c on ci. Data types are registered after the types they depend on
+// regardless of their order in c. Dependencies that are not in c must already be registered on ci.
+func (ci *ConnInfo) RegisterCatalog(c *Catalog) error {
+	if c.Version != CatalogVersion {
+		return fmt.Errorf("unsupported catalog version: %d", c.Version)
+	}
+
+	r := &catalogRegisterer{
+		ci:         ci,
+		types:      make(map[uint32]*CatalogType, len(c.Types)),
+		state:      make(map[uint32]bool, len(c.Types)),
+		baseValues: catalogBaseValues(),
+	}
+	for i := range c.Types {
+		r.types[c.Types[i].OID] = &c.Types[i]
+	}
+
+	for i := range c.Types {
+		if err := r.register(c.Types[i].OID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// catalogRegisterer registers the types of a Catalog in dependency order.
+type catalogRegisterer struct {
+	ci    *ConnInfo
+	types map[uint32]*CatalogType
+
+	// state is false while a type is being registered and true once it has been registered.
+	state map[uint32]bool
+
+	baseValues map[string]Value
+}
+
+func (r *catalogRegisterer) register(oid uint32) error {
+	t, ok := r.types[oid]
+	if !ok {
+		return nil
+	}
+
+	if done, ok := r.state[oid]; ok {
+		if done {
+			return nil
+		}
+		return fmt.Errorf("cyclic type dependency on oid: %d", oid)
+	}
+
+	r.state[oid] = false
+
+	var deps []uint32
+	switch t.Kind {
+	case CatalogKindComposite:
+		for _, f := range t.Fields {
+			deps = append(deps, f.OID)
+		}
+	case CatalogKindArray, CatalogKindRange:
+		deps = append(deps, t.ElementOID)
+	case CatalogKindDomain:
+		deps = append(deps, t.BaseOID)
+	}
+
+	for _, depOID := range deps {
+		if err := r.register(depOID); err != nil {
+			return err
+		}
+	}
+
+	value, err := r.newValue(t)
+	if err != nil {
+		return fmt.Errorf("cannot register data type %s from catalog: %w", t.Name, err)
+	}
+
+	r.ci.RegisterDataType(DataType{Value: value, Name: t.Name, OID: t.OID})
+	r.state[oid] = true
+	return nil
+}
+
+func (r *catalogRegisterer) newValue(t *CatalogType) (Value, error) {
+	switch t.Kind {
+	case CatalogKindEnum:
+		return NewEnumType(t.Name, t.Members), nil
+	case CatalogKindComposite:
+		fields := make([]CompositeTypeField, len(t.Fields))
+		for i, f := range t.Fields {
+			fields[i] = CompositeTypeField{Name: f.Name, OID: f.OID}
+		}
+		return NewCompositeType(t.Name, fields, r.ci)
+	case CatalogKindArray:
+		newElement, err := r.newValueTranscoderFunc(t.ElementOID)
+		if err != nil {
+			return nil, err
+		}
+		return NewArrayType(t.Name, t.ElementOID, newElement), nil
+	case CatalogKindRange:
+		newElement, err := r.newValueTranscoderFunc(t.ElementOID)
+		if err != nil {
+			return nil, err
+		}
+		return NewRangeType(t.Name, t.ElementOID, newElement), nil
+	case CatalogKindDomain:
+		newBase, err := r.newValueTranscoderFunc(t.BaseOID)
+		if err != nil {
+			return nil, err
+		}
+		return NewDomainType(t.Name, t.BaseOID, newBase, nil), nil
+	case CatalogKindBase:
+		value, ok := r.baseValues[t.Value]
+		if !ok {
+			return nil, fmt.Errorf("unknown value: %s", t.Value)
+		}
+		return NewValue(value), nil
+	default:
+		return nil, fmt.Errorf("unknown kind: %s", t.Kind)
+	}
+}
+
+func (r *catalogRegisterer) newValueTranscoderFunc(oid uint32) (func() ValueTranscoder, error) {
+	dt, ok := r.ci.DataTypeForOID(oid)
+	if !ok {
+		return nil, fmt.Errorf("no data type registered for oid: %d", oid)
+	}
+
+	vt, ok := dt.Value.(ValueTranscoder)
+	if !ok {
+		return nil, fmt.Errorf("data type for oid does not implement ValueTranscoder: %d", oid)
+	}
+
+	return func() ValueTranscoder {
+		return NewValue(vt).(ValueTranscoder)
+	}, nil
+}
@@ -0,0 +1,142 @@
+package pgtype_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func newCatalogTestConnInfo(t *testing.T) *pgtype.ConnInfo {
+	ci := pgtype.NewConnInfo()
+
+	ci.RegisterDataType(pgtype.DataType{Value: pgtype.NewEnumType("mood", []string{"happy", "sad"}), Name: "mood", OID: 200000})
+
+	ct, err := pgtype.NewCompositeType("person", []pgtype.CompositeTypeField{
+		{Name: "name", OID: pgtype.TextOID},
+		{Name: "mood", OID: 200000},
+	}, ci)
+	require.NoError(t, err)
+	ci.RegisterDataType(pgtype.DataType{Value: ct, Name: "person", OID: 200001})
+	require.NoError(t, ci.RegisterArrayType(200002, 200001))
+
+	ci.RegisterDataType(pgtype.DataType{
+		Value: pgtype.NewRangeType("floatrange", pgtype.Float8OID, func() pgtype.ValueTranscoder { return &pgtype.Float8{} }),
+		Name:  "floatrange",
+		OID:   200003,
+	})
+	ci.RegisterDataType(pgtype.DataType{
+		Value: pgtype.NewDomainType("positive_amount", pgtype.Int8OID, func() pgtype.ValueTranscoder { return &pgtype.Int8{} }, nil),
+		Name:  "positive_amount",
+		OID:   200004,
+	})
+	ci.RegisterDataType(pgtype.DataType{Value: &pgtype.Hstore{}, Name: "hstore", OID: 200005})
+
+	return ci
+}
+
+func TestConnInfoCatalog(t *testing.T) {
+	ci := newCatalogTestConnInfo(t)
+
+	catalog, err := ci.Catalog()
+	require.NoError(t, err)
+	require.Equal(t, &pgtype.Catalog{
+		Version: pgtype.CatalogVersion,
+		Types: []pgtype.CatalogType{
+			{Name: "mood", OID: 200000, Kind: pgtype.CatalogKindEnum, Members: []string{"happy", "sad"}},
+			{Name: "person", OID: 200001, Kind: pgtype.CatalogKindComposite, Fields: []pgtype.CatalogField{
+				{Name: "name", OID: pgtype.TextOID},
+				{Name: "mood", OID: 200000},
+			}},
+			{Name: "_person", OID: 200002, Kind: pgtype.CatalogKindArray, ElementOID: 200001},
+			{Name: "floatrange", OID: 200003, Kind: pgtype.CatalogKindRange, ElementOID: pgtype.Float8OID},
+			{Name: "positive_amount", OID: 200004, Kind: pgtype.CatalogKindDomain, BaseOID: pgtype.Int8OID},
+			{Name: "hstore", OID: 200005, Kind: pgtype.CatalogKindBase, Value: "Hstore"},
+		},
+	}, catalog)
+
+	catalog, err = pgtype.NewConnInfo().Catalog()
+	require.NoError(t, err)
+	require.Empty(t, catalog.Types)
+}
+
+func TestConnInfoRegisterCatalog(t *testing.T) {
+	catalog, err := newCatalogTestConnInfo(t).Catalog()
+	require.NoError(t, err)
+
+	buf, err := json.Marshal(catalog)
+	require.NoError(t, err)
+
+	var loaded pgtype.Catalog
+	err = json.Unmarshal(buf, &loaded)
+	require.NoError(t, err)
+
+	// Types must be registered after their dependencies regardless of their order in the catalog.
+	for i, j := 0, len(loaded.Types)-1; i < j; i, j = i+1, j-1 {
+		loaded.Types[i], loaded.Types[j] = loaded.Types[j], loaded.Types[i]
+	}
+
+	ci := pgtype.NewConnInfo()
+	err = ci.RegisterCatalog(&loaded)
+	require.NoError(t, err)
+
+	rebuilt, err := ci.Catalog()
+	require.NoError(t, err)
+	require.Equal(t, catalog, rebuilt)
+
+	dt, ok := ci.DataTypeForName("_person")
+	require.True(t, ok)
+	people := pgtype.NewValue(dt.Value).(pgtype.ValueTranscoder)
+	err = people.DecodeText(ci, []byte(`{"(alice,happy)","(bob,sad)"}`))
+	require.NoError(t, err)
+
+	type person struct {
+		Name string
+		Mood string
+	}
+
+	var dst []person
+	err = people.AssignTo(&dst)
+	require.NoError(t, err)
+	require.Equal(t, []person{{Name: "alice", Mood: "happy"}, {Name: "bob", Mood: "sad"}}, dst)
+}
+
+func TestConnInfoRegisterCatalogBaseTime(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	ci.RegisterDataType(pgtype.DataType{Value: &pgtype.Time{}, Name: "time_of_day", OID: 200000})
+
+	catalog, err := ci.Catalog()
+	require.NoError(t, err)
+	require.Equal(t, []pgtype.CatalogType{{Name: "time_of_day", OID: 200000, Kind: pgtype.CatalogKindBase, Value: "Time"}}, catalog.Types)
+
+	ci = pgtype.NewConnInfo()
+	err = ci.RegisterCatalog(catalog)
+	require.NoError(t, err)
+
+	dt, ok := ci.DataTypeForOID(200000)
+	require.True(t, ok)
+	require.IsType(t, &pgtype.Time{}, dt.Value)
+}
+
+func TestConnInfoRegisterCatalogErrors(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	err := ci.RegisterCatalog(&pgtype.Catalog{Version: pgtype.CatalogVersion + 1})
+	require.Error(t, err)
+
+	err = ci.RegisterCatalog(&pgtype.Catalog{
+		Version: pgtype.CatalogVersion,
+		Types:   []pgtype.CatalogType{{Name: "_missing", OID: 200000, Kind: pgtype.CatalogKindArray, ElementOID: 200001}},
+	})
+	require.Error(t, err)
+
+	err = ci.RegisterCatalog(&pgtype.Catalog{
+		Version: pgtype.CatalogVersion,
+		Types: []pgtype.CatalogType{
+			{Name: "a", OID: 200000, Kind: pgtype.CatalogKindDomain, BaseOID: 200001},
+			{Name: "b", OID: 200001, Kind: pgtype.CatalogKindDomain, BaseOID: 200000},
+		},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestCIDArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "cid[]", []interface{}{
+		&pgtype.CIDArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.CIDArray{
+			Elements: []pgtype.CID{
+				{Uint: 42, Status: pgtype.Present},
+				{Uint: 4294967295, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.CIDArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestCircleArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "circle[]", []interface{}{
+		&pgtype.CircleArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.CircleArray{
+			Elements: []pgtype.Circle{
+				{P: pgtype.Vec2{1.234, 5.67890123}, R: 3.5, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.CircleArray{Status: pgtype.Null},
+	})
+}
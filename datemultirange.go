@@ -0,0 +1,229 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgio"
+)
+
+type Datemultirange struct {
+	Ranges []Daterange
+	Status Status
+}
+
+func (dst *Datemultirange) Set(src interface{}) error {
+	// untyped nil and typed nil interfaces are different
+	if src == nil {
+		*dst = Datemultirange{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Datemultirange:
+		*dst = value
+	case *Datemultirange:
+		*dst = *value
+	case []Daterange:
+		if value == nil {
+			*dst = Datemultirange{Status: Null}
+		} else {
+			*dst = Datemultirange{Ranges: value, Status: Present}
+		}
+	case []*Daterange:
+		if value == nil {
+			*dst = Datemultirange{Status: Null}
+		} else {
+			ranges := make([]Daterange, len(value))
+			for i := range value {
+				if value[i] == nil {
+					return fmt.Errorf("cannot convert nil element of %v to Datemultirange", src)
+				}
+				ranges[i] = *value[i]
+			}
+			*dst = Datemultirange{Ranges: ranges, Status: Present}
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	default:
+		return fmt.Errorf("cannot convert %v to Datemultirange", src)
+	}
+
+	return nil
+}
+
+func (dst Datemultirange) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Datemultirange) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *[]Daterange:
+			*v = make([]Daterange, len(src.Ranges))
+			copy(*v, src.Ranges)
+			return nil
+		case *[]*Daterange:
+			*v = make([]*Daterange, len(src.Ranges))
+			for i := range src.Ranges {
+				r := src.Ranges[i]
+				(*v)[i] = &r
+			}
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *Datemultirange) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Datemultirange{Status: Null}
+		return nil
+	}
+
+	utmr, err := ParseUntypedTextMultirange(string(src))
+	if err != nil {
+		return err
+	}
+
+	var ranges []Daterange
+
+	if len(utmr.Elements) > 0 {
+		ranges = make([]Daterange, len(utmr.Elements))
+
+		for i, s := range utmr.Elements {
+			if err := ranges[i].DecodeText(ci, []byte(s)); err != nil {
+				return err
+			}
+		}
+	}
+
+	*dst = Datemultirange{Ranges: ranges, Status: Present}
+
+	return nil
+}
+
+func (dst *Datemultirange) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Datemultirange{Status: Null}
+		return nil
+	}
+
+	ubmr, err := ParseUntypedBinaryMultirange(src)
+	if err != nil {
+		return err
+	}
+
+	var ranges []Daterange
+
+	if len(ubmr.Elements) > 0 {
+		ranges = make([]Daterange, len(ubmr.Elements))
+
+		for i, elemSrc := range ubmr.Elements {
+			if err := ranges[i].DecodeBinary(ci, elemSrc); err != nil {
+				return err
+			}
+		}
+	}
+
+	*dst = Datemultirange{Ranges: ranges, Status: Present}
+
+	return nil
+}
+
+func (src Datemultirange) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = append(buf, '{')
+
+	for i := range src.Ranges {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+
+		var err error
+		buf, err = src.Ranges[i].EncodeText(ci, buf)
+		if err != nil {
+			return nil, err
+		} else if buf == nil {
+			return nil, fmt.Errorf("Datemultirange cannot contain NULL ranges")
+		}
+	}
+
+	buf = append(buf, '}')
+
+	return buf, nil
+}
+
+func (src Datemultirange) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = pgio.AppendInt32(buf, int32(len(src.Ranges)))
+
+	for i := range src.Ranges {
+		sp := len(buf)
+		buf = pgio.AppendInt32(buf, -1)
+
+		var err error
+		buf, err = src.Ranges[i].EncodeBinary(ci, buf)
+		if err != nil {
+			return nil, err
+		} else if buf == nil {
+			return nil, fmt.Errorf("Datemultirange cannot contain NULL ranges")
+		}
+
+		pgio.SetInt32(buf[sp:], int32(len(buf[sp:])-4))
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Datemultirange) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Datemultirange{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Datemultirange) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
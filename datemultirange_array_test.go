@@ -0,0 +1,29 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestDatemultirangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "datemultirange[]", []interface{}{
+		&pgtype.DatemultirangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.DatemultirangeArray{
+			Elements: []pgtype.Datemultirange{
+				{Status: pgtype.Present},
+				{Ranges: []pgtype.Daterange{{Lower: pgtype.Date{Time: time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}, Upper: pgtype.Date{Time: time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.DatemultirangeArray{Status: pgtype.Null},
+	})
+}
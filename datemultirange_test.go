@@ -0,0 +1,56 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestDatemultirangeTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "datemultirange", []interface{}{
+		&pgtype.Datemultirange{Status: pgtype.Present},
+		&pgtype.Datemultirange{
+			Ranges: []pgtype.Daterange{
+				{
+					Lower:     pgtype.Date{Time: time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+					Upper:     pgtype.Date{Time: time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Exclusive,
+					Status:    pgtype.Present,
+				},
+				{
+					Lower:     pgtype.Date{Time: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Unbounded,
+					Status:    pgtype.Present,
+				},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.Datemultirange{Status: pgtype.Null},
+	}, func(aa, bb interface{}) bool {
+		a := aa.(pgtype.Datemultirange)
+		b := bb.(pgtype.Datemultirange)
+
+		if a.Status != b.Status || len(a.Ranges) != len(b.Ranges) {
+			return false
+		}
+
+		for i := range a.Ranges {
+			ar, br := a.Ranges[i], b.Ranges[i]
+			if ar.Status != br.Status ||
+				ar.LowerType != br.LowerType ||
+				ar.UpperType != br.UpperType ||
+				!ar.Lower.Time.Equal(br.Lower.Time) ||
+				ar.Lower.Status != br.Lower.Status ||
+				!ar.Upper.Time.Equal(br.Upper.Time) ||
+				ar.Upper.Status != br.Upper.Status {
+				return false
+			}
+		}
+
+		return true
+	})
+}
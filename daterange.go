@@ -30,9 +30,40 @@ func (dst *Daterange) Set(src interface{}) error {
 	case string:
 		return dst.DecodeText(nil, []byte(value))
 	default:
-		return fmt.Errorf("cannot convert %v to Daterange", src)
+		gr, ok, err := rangeFromGoValue(src)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("cannot convert %v to Daterange", src)
+		}
+		return dst.setGenericRange(gr)
+	}
+
+	return nil
+}
+
+func (dst *Daterange) setGenericRange(gr *genericRange) error {
+	if gr == nil {
+		*dst = Daterange{Status: Null}
+		return nil
 	}
 
+	r := Daterange{LowerType: gr.LowerType, UpperType: gr.UpperType, Status: Present}
+
+	if r.LowerType == Inclusive || r.LowerType == Exclusive {
+		if err := r.Lower.Set(gr.Lower); err != nil {
+			return err
+		}
+	}
+
+	if r.UpperType == Inclusive || r.UpperType == Exclusive {
+		if err := r.Upper.Set(gr.Upper); err != nil {
+			return err
+		}
+	}
+
+	*dst = r
 	return nil
 }
 
@@ -47,8 +78,32 @@ func (dst Daterange) Get() interface{} {
 	}
 }
 
+// AssignTo assigns src to dst. dst may be a pointer to a struct with Lower and Upper fields or a pointer to a two
+// element array. A struct may also have LowerType and UpperType BoundType fields, LowerInclusive and UpperInclusive
+// bool fields, and an Empty bool field to represent bound types and empty ranges. Without them only ranges in
+// [lower,upper) form can be assigned. Unbounded bounds are assigned as nil to pointer fields.
 func (src *Daterange) AssignTo(dst interface{}) error {
-	return fmt.Errorf("cannot assign %v to %T", src, dst)
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *Daterange:
+			*v = *src
+			return nil
+		default:
+			if isRange, err := assignRangeToGoValue(&src.Lower, &src.Upper, src.LowerType, src.UpperType, dst); isRange {
+				return err
+			}
+
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("cannot assign %v to %T", src, dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
 }
 
 func (dst *Daterange) DecodeText(ci *ConnInfo, src []byte) error {
@@ -265,3 +320,155 @@ func (dst *Daterange) Scan(src interface{}) error {
 func (src Daterange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Daterange) rangeOps() rangeOps {
+
+	return rangeOps{compare: compareDate, canonicalize: canonicalizeDate}
+
+}
+
+// rangeBounds returns the canonical form of src so operations give the same results as the server which only ever
+// operates on canonical ranges.
+func (src Daterange) rangeBounds() rangeBounds {
+	return src.rangeOps().canonical(newRangeBounds(src.Lower, src.Upper, src.LowerType, src.UpperType))
+}
+
+func (dst *Daterange) setRangeBounds(rb rangeBounds) {
+	if rb.empty {
+		*dst = Daterange{LowerType: Empty, UpperType: Empty, Status: Present}
+		return
+	}
+
+	*dst = Daterange{LowerType: rb.lowerType(), UpperType: rb.upperType(), Status: Present}
+	if !rb.lower.infinite {
+		dst.Lower = rb.lower.value.(Date)
+	}
+	if !rb.upper.infinite {
+		dst.Upper = rb.upper.value.(Date)
+	}
+}
+
+// SetCanonical is like Set but converts the result to canonical form as described by Canonical.
+func (dst *Daterange) SetCanonical(src interface{}) error {
+	if err := dst.Set(src); err != nil {
+		return err
+	}
+
+	*dst = dst.Canonical()
+	return nil
+}
+
+// Canonical returns src in the form the server would return it. Ranges that contain no points such as [5,5) are
+// converted to the empty range. As Daterange is a discrete range the bounds are also
+// converted to [lower,upper) form. e.g. an inclusive upper bound is replaced by the next value as an exclusive bound.
+// If src is not present it is returned unchanged. Encode the result of Canonical to send a value in canonical form.
+func (src Daterange) Canonical() Daterange {
+	if src.Status != Present {
+		return src
+	}
+
+	var result Daterange
+	result.setRangeBounds(src.rangeBounds())
+	return result
+}
+
+// Equal returns true if src and other represent the same range. It is equivalent to the PostgreSQL = operator applied
+// to the canonical forms of src and other. Two values that are not present are equal if their Status is the same.
+func (src Daterange) Equal(other Daterange) bool {
+	if src.Status != Present || other.Status != Present {
+		return src.Status == other.Status
+	}
+
+	return src.rangeOps().equal(src.rangeBounds(), other.rangeBounds())
+}
+
+// IsEmpty returns true if src is the empty range or a range that contains no points such as [5,5).
+func (src Daterange) IsEmpty() bool {
+	return src.Status == Present && src.rangeBounds().empty
+}
+
+// ContainsElement returns true if elem is within src. It is equivalent to the PostgreSQL @> operator with an element
+// operand. It returns false if src or elem is not present.
+func (src Daterange) ContainsElement(elem Date) bool {
+	if src.Status != Present || elem.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().containsElement(src.rangeBounds(), elem)
+}
+
+// ContainsRange returns true if other is within src. It is equivalent to the PostgreSQL @> operator. Every range
+// contains the empty range. It returns false if src or other is not present.
+func (src Daterange) ContainsRange(other Daterange) bool {
+	if src.Status != Present || other.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().contains(src.rangeBounds(), other.rangeBounds())
+}
+
+// Overlaps returns true if src and other have points in common. It is equivalent to the PostgreSQL && operator. It
+// returns false if src or other is not present.
+func (src Daterange) Overlaps(other Daterange) bool {
+	if src.Status != Present || other.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().overlaps(src.rangeBounds(), other.rangeBounds())
+}
+
+// Adjacent returns true if src and other are adjacent. It is equivalent to the PostgreSQL -|- operator. It returns
+// false if src or other is not present.
+func (src Daterange) Adjacent(other Daterange) bool {
+	if src.Status != Present || other.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().adjacent(src.rangeBounds(), other.rangeBounds())
+}
+
+// Union returns the union of src and other. It is equivalent to the PostgreSQL + operator. As with PostgreSQL an error
+// is returned if the result would not be contiguous. The result is null if src or other is not present.
+func (src Daterange) Union(other Daterange) (Daterange, error) {
+	if src.Status != Present || other.Status != Present {
+		return Daterange{Status: Null}, nil
+	}
+
+	rb, err := src.rangeOps().union(src.rangeBounds(), other.rangeBounds())
+	if err != nil {
+		return Daterange{}, err
+	}
+
+	var result Daterange
+	result.setRangeBounds(rb)
+	return result, nil
+}
+
+// Intersect returns the intersection of src and other. It is equivalent to the PostgreSQL * operator. The result is
+// null if src or other is not present.
+func (src Daterange) Intersect(other Daterange) Daterange {
+	if src.Status != Present || other.Status != Present {
+		return Daterange{Status: Null}
+	}
+
+	var result Daterange
+	result.setRangeBounds(src.rangeOps().intersect(src.rangeBounds(), other.rangeBounds()))
+	return result
+}
+
+// Difference returns src with other removed. It is equivalent to the PostgreSQL - operator. As with PostgreSQL an
+// error is returned if the result would not be contiguous. The result is null if src or other is not present.
+func (src Daterange) Difference(other Daterange) (Daterange, error) {
+	if src.Status != Present || other.Status != Present {
+		return Daterange{Status: Null}, nil
+	}
+
+	rb, err := src.rangeOps().difference(src.rangeBounds(), other.rangeBounds())
+	if err != nil {
+		return Daterange{}, err
+	}
+
+	var result Daterange
+	result.setRangeBounds(rb)
+	return result, nil
+}
@@ -0,0 +1,115 @@
+package pgtype
+
+import "fmt"
+
+// DomainType represents a domain type. While it implements Value, this is only in service of its type conversion duties
+// when registered as a data type in a ConnType. It should not be used directly as a Value. DomainType delegates all
+// encoding and decoding to the Value of the base type of the domain.
+//
+// DomainType can optionally validate values before they are sent to PostgreSQL. This allows domain CHECK constraints to
+// be checked client side.
+type DomainType struct {
+	base ValueTranscoder
+
+	typeName string
+	baseOID  uint32
+	newBase  func() ValueTranscoder
+	validate func(value interface{}) error
+}
+
+// NewDomainType creates a DomainType for the domain type typeName whose base type has baseOID. newBase is used to
+// create the Value for the base type. If validate is not nil it is called by Set with the result of Get of the base
+// Value. A non-nil error returned by validate is returned by Set. validate is not called for NULL values.
+func NewDomainType(typeName string, baseOID uint32, newBase func() ValueTranscoder, validate func(value interface{}) error) *DomainType {
+	return &DomainType{base: newBase(), typeName: typeName, baseOID: baseOID, newBase: newBase, validate: validate}
+}
+
+func (dt *DomainType) NewTypeValue() Value {
+	return &DomainType{
+		base: dt.newBase(),
+
+		typeName: dt.typeName,
+		baseOID:  dt.baseOID,
+		newBase:  dt.newBase,
+		validate: dt.validate,
+	}
+}
+
+func (dt *DomainType) TypeName() string {
+	return dt.typeName
+}
+
+// BaseOID returns the OID of the base type of the domain.
+func (dt *DomainType) BaseOID() uint32 {
+	return dt.baseOID
+}
+
+// WithValidator returns a copy of dt that uses validate. It can be used to add validation to a DomainType loaded from
+// the database.
+func (dt *DomainType) WithValidator(validate func(value interface{}) error) *DomainType {
+	return &DomainType{
+		base: dt.newBase(),
+
+		typeName: dt.typeName,
+		baseOID:  dt.baseOID,
+		newBase:  dt.newBase,
+		validate: validate,
+	}
+}
+
+// Set sets the base Value to src. If src is rejected by the validator dst is not changed.
+func (dst *DomainType) Set(src interface{}) error {
+	base := dst.newBase()
+	if err := base.Set(src); err != nil {
+		return err
+	}
+
+	if dst.validate != nil {
+		if value := base.Get(); value != nil {
+			if err := dst.validate(value); err != nil {
+				return fmt.Errorf("invalid value for domain %s: %w", dst.typeName, err)
+			}
+		}
+	}
+
+	dst.base = base
+	return nil
+}
+
+func (dst DomainType) Get() interface{} {
+	return dst.base.Get()
+}
+
+func (src *DomainType) AssignTo(dst interface{}) error {
+	return src.base.AssignTo(dst)
+}
+
+func (src DomainType) PreferredParamFormat() int16 {
+	if pfp, ok := src.base.(ParamFormatPreferrer); ok {
+		return pfp.PreferredParamFormat()
+	}
+	return BinaryFormatCode
+}
+
+func (src DomainType) PreferredResultFormat() int16 {
+	if rfp, ok := src.base.(ResultFormatPreferrer); ok {
+		return rfp.PreferredResultFormat()
+	}
+	return BinaryFormatCode
+}
+
+func (dst *DomainType) DecodeText(ci *ConnInfo, src []byte) error {
+	return dst.base.DecodeText(ci, src)
+}
+
+func (dst *DomainType) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return dst.base.DecodeBinary(ci, src)
+}
+
+func (src DomainType) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return src.base.EncodeText(ci, buf)
+}
+
+func (src DomainType) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return src.base.EncodeBinary(ci, buf)
+}
@@ -0,0 +1,125 @@
+package pgtype_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/matthewpi/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidEmail = errors.New("email must contain @")
+
+func newEmailDomainType() *pgtype.DomainType {
+	return pgtype.NewDomainType("email_address", pgtype.TextOID, func() pgtype.ValueTranscoder { return &pgtype.Text{} }, func(value interface{}) error {
+		if !strings.Contains(value.(string), "@") {
+			return errInvalidEmail
+		}
+		return nil
+	})
+}
+
+func TestDomainTypeValue(t *testing.T) {
+	domainType := newEmailDomainType()
+
+	err := domainType.Set("alice@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", domainType.Get())
+
+	buf, err := domainType.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", string(buf))
+
+	var s string
+	err = domainType.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", s)
+
+	err = domainType.Set("not an email")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, errInvalidEmail))
+	require.Equal(t, "alice@example.com", domainType.Get())
+
+	buf, err = domainType.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", string(buf))
+
+	err = domainType.Set(nil)
+	require.NoError(t, err)
+	require.Nil(t, domainType.Get())
+
+	err = domainType.DecodeBinary(nil, []byte("bob@example.com"))
+	require.NoError(t, err)
+	require.Equal(t, "bob@example.com", domainType.Get())
+}
+
+func TestDomainTypeWithValidator(t *testing.T) {
+	domainType := pgtype.NewDomainType("positive_amount", pgtype.Int8OID, func() pgtype.ValueTranscoder { return &pgtype.Int8{} }, nil)
+
+	err := domainType.Set(-1)
+	require.NoError(t, err)
+
+	domainType = domainType.WithValidator(func(value interface{}) error {
+		if value.(int64) <= 0 {
+			return errors.New("amount must be positive")
+		}
+		return nil
+	})
+	require.Equal(t, "positive_amount", domainType.TypeName())
+	require.EqualValues(t, pgtype.Int8OID, domainType.BaseOID())
+
+	err = domainType.Set(-1)
+	require.Error(t, err)
+
+	err = domainType.Set(1)
+	require.NoError(t, err)
+}
+
+func TestDomainTypePreferredFormats(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	amountDomain := pgtype.NewDomainType("positive_amount", pgtype.Int8OID, func() pgtype.ValueTranscoder { return &pgtype.Int8{} }, nil)
+	ci.RegisterDataType(pgtype.DataType{Value: amountDomain, Name: "positive_amount", OID: 200000})
+	require.EqualValues(t, pgtype.BinaryFormatCode, ci.ParamFormatCodeForOID(200000))
+	require.EqualValues(t, pgtype.BinaryFormatCode, ci.ResultFormatCodeForOID(200000))
+
+	enumType := pgtype.NewEnumType("mood", []string{"happy", "sad"})
+	moodDomain := pgtype.NewDomainType("happy_mood", 200001, func() pgtype.ValueTranscoder {
+		return pgtype.NewValue(enumType).(pgtype.ValueTranscoder)
+	}, nil)
+	ci.RegisterDataType(pgtype.DataType{Value: moodDomain, Name: "happy_mood", OID: 200002})
+	require.EqualValues(t, pgtype.TextFormatCode, ci.ParamFormatCodeForOID(200002))
+	require.EqualValues(t, pgtype.TextFormatCode, ci.ResultFormatCodeForOID(200002))
+}
+
+func TestDomainTypeTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `drop domain if exists pgtype_email_address;
+
+create domain pgtype_email_address as text check (value like '%@%');`)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), "drop domain if exists pgtype_email_address")
+
+	var oid uint32
+	err = conn.QueryRow(context.Background(), "select 'pgtype_email_address'::regtype::oid").Scan(&oid)
+	require.NoError(t, err)
+
+	conn.ConnInfo().RegisterDataType(pgtype.DataType{Value: newEmailDomainType(), Name: "pgtype_email_address", OID: oid})
+
+	for _, format := range []int16{pgx.TextFormatCode, pgx.BinaryFormatCode} {
+		var dst string
+		err := conn.QueryRow(context.Background(), "select $1::pgtype_email_address", pgx.QueryResultFormats{format}, "alice@example.com").Scan(&dst)
+		require.NoError(t, err)
+		require.Equal(t, "alice@example.com", dst)
+	}
+
+	var dst string
+	err = conn.QueryRow(context.Background(), "select $1::pgtype_email_address", "not an email").Scan(&dst)
+	require.True(t, errors.Is(err, errInvalidEmail))
+}
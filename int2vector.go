@@ -0,0 +1,244 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// Int2Vector represents a PostgreSQL int2vector value. int2vector is used in the system catalogs such as for
+// pg_index.indkey. The text format is the elements separated by spaces. The binary format is the same as a one
+// dimensional int2 array with a lower bound of 0 and no NULL elements.
+type Int2Vector struct {
+	Elements []int16
+	Status   Status
+}
+
+func (dst *Int2Vector) Set(src interface{}) error {
+	if src == nil {
+		*dst = Int2Vector{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Int2Vector:
+		*dst = value
+	case *Int2Vector:
+		if value == nil {
+			*dst = Int2Vector{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case []int16:
+		if value == nil {
+			*dst = Int2Vector{Status: Null}
+		} else {
+			elements := make([]int16, len(value))
+			copy(elements, value)
+			*dst = Int2Vector{Elements: elements, Status: Present}
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = Int2Vector{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		if originalSrc, ok := underlyingSliceType(src); ok {
+			return dst.Set(originalSrc)
+		}
+		return fmt.Errorf("cannot convert %v to Int2Vector", value)
+	}
+
+	return nil
+}
+
+func (dst Int2Vector) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Int2Vector) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *[]int16:
+			*v = make([]int16, len(src.Elements))
+			copy(*v, src.Elements)
+			return nil
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *Int2Vector) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Int2Vector{Status: Null}
+		return nil
+	}
+
+	fields := strings.Fields(string(src))
+	elements := make([]int16, len(fields))
+	for i, f := range fields {
+		n, err := strconv.ParseInt(f, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid int2vector element %q: %w", f, err)
+		}
+		elements[i] = int16(n)
+	}
+
+	*dst = Int2Vector{Elements: elements, Status: Present}
+	return nil
+}
+
+func (dst *Int2Vector) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Int2Vector{Status: Null}
+		return nil
+	}
+
+	elementSrcs, err := decodeVectorBinary("int2vector", Int2OID, 2, src)
+	if err != nil {
+		return err
+	}
+
+	elements := make([]int16, len(elementSrcs))
+	for i, elemSrc := range elementSrcs {
+		elements[i] = int16(binary.BigEndian.Uint16(elemSrc))
+	}
+
+	*dst = Int2Vector{Elements: elements, Status: Present}
+	return nil
+}
+
+// decodeVectorBinary decodes the binary format of a vector type such as int2vector or oidvector. The format is that of
+// a one dimensional array without NULL elements. It returns the source of each element after checking that each is
+// elementLen bytes.
+func decodeVectorBinary(typeName string, elementOID uint32, elementLen int, src []byte) ([][]byte, error) {
+	var arrayHeader ArrayHeader
+	rp, err := arrayHeader.DecodeBinary(nil, src)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(arrayHeader.Dimensions) > 1 || arrayHeader.ContainsNull || uint32(arrayHeader.ElementOID) != elementOID {
+		return nil, fmt.Errorf("invalid %s data", typeName)
+	}
+
+	if len(arrayHeader.Dimensions) == 0 {
+		return nil, nil
+	}
+
+	count := int(arrayHeader.Dimensions[0].Length)
+	if count < 0 || len(src[rp:]) != count*(4+elementLen) {
+		return nil, fmt.Errorf("invalid %s data", typeName)
+	}
+
+	elementSrcs := make([][]byte, count)
+	for i := range elementSrcs {
+		if int(int32(binary.BigEndian.Uint32(src[rp:]))) != elementLen {
+			return nil, fmt.Errorf("invalid %s data", typeName)
+		}
+		rp += 4
+		elementSrcs[i] = src[rp : rp+elementLen]
+		rp += elementLen
+	}
+
+	return elementSrcs, nil
+}
+
+func (src Int2Vector) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	// An empty int2vector is an empty string, not NULL.
+	if buf == nil {
+		buf = []byte{}
+	}
+
+	for i, n := range src.Elements {
+		if i > 0 {
+			buf = append(buf, ' ')
+		}
+		buf = strconv.AppendInt(buf, int64(n), 10)
+	}
+
+	return buf, nil
+}
+
+func (src Int2Vector) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	arrayHeader := ArrayHeader{
+		ElementOID: Int2OID,
+		Dimensions: []ArrayDimension{{Length: int32(len(src.Elements)), LowerBound: 0}},
+	}
+	buf = arrayHeader.EncodeBinary(ci, buf)
+
+	for _, n := range src.Elements {
+		buf = pgio.AppendInt32(buf, 2)
+		buf = pgio.AppendInt16(buf, n)
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Int2Vector) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Int2Vector{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Int2Vector) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
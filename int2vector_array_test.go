@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestInt2VectorArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int2vector[]", []interface{}{
+		&pgtype.Int2VectorArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int2VectorArray{
+			Elements: []pgtype.Int2Vector{
+				{Elements: []int16{}, Status: pgtype.Present},
+				{Elements: []int16{1, -2, 3}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int2VectorArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,108 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt2VectorTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int2vector", []interface{}{
+		&pgtype.Int2Vector{Elements: []int16{1}, Status: pgtype.Present},
+		&pgtype.Int2Vector{Elements: []int16{1, -2, 32767}, Status: pgtype.Present},
+		&pgtype.Int2Vector{Status: pgtype.Null},
+	})
+}
+
+func TestInt2VectorDecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result pgtype.Int2Vector
+	}{
+		{source: "", result: pgtype.Int2Vector{Elements: []int16{}, Status: pgtype.Present}},
+		{source: "1", result: pgtype.Int2Vector{Elements: []int16{1}, Status: pgtype.Present}},
+		{source: " 1 -2  3 ", result: pgtype.Int2Vector{Elements: []int16{1, -2, 3}, Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Int2Vector
+		err := r.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, tt.result, r, "%d: %s", i, tt.source)
+	}
+
+	for i, source := range []string{"a", "1,2", "32768"} {
+		var r pgtype.Int2Vector
+		err := r.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestInt2VectorEncodeText(t *testing.T) {
+	buf, err := pgtype.Int2Vector{Elements: []int16{1, -2, 3}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "1 -2 3", string(buf))
+
+	buf, err = pgtype.Int2Vector{Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, buf)
+	require.Empty(t, buf)
+}
+
+func TestInt2VectorBinaryRoundTrip(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	src := pgtype.Int2Vector{Elements: []int16{1, -2, 3}, Status: pgtype.Present}
+	buf, err := src.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	var dst pgtype.Int2Vector
+	require.NoError(t, dst.DecodeBinary(ci, buf))
+	require.Equal(t, src, dst)
+
+	// The binary format of int2vector is the same as int2[] so it must be rejected as another element type.
+	var oidVector pgtype.OIDVector
+	require.Error(t, oidVector.DecodeBinary(ci, buf))
+
+	// int2[] with NULL elements is not a valid int2vector.
+	arrayBuf, err := pgtype.Int2Array{
+		Elements:   []pgtype.Int2{{Int: 1, Status: pgtype.Present}, {Status: pgtype.Null}},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	require.Error(t, dst.DecodeBinary(ci, arrayBuf))
+}
+
+func TestInt2VectorSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Int2Vector
+	}{
+		{source: []int16{1, 2}, result: pgtype.Int2Vector{Elements: []int16{1, 2}, Status: pgtype.Present}},
+		{source: "1 2", result: pgtype.Int2Vector{Elements: []int16{1, 2}, Status: pgtype.Present}},
+		{source: ([]int16)(nil), result: pgtype.Int2Vector{Status: pgtype.Null}},
+		{source: nil, result: pgtype.Int2Vector{Status: pgtype.Null}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Int2Vector
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+}
+
+func TestInt2VectorAssignTo(t *testing.T) {
+	src := pgtype.Int2Vector{Elements: []int16{1, 2}, Status: pgtype.Present}
+
+	var s []int16
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, []int16{1, 2}, s)
+
+	null := pgtype.Int2Vector{Status: pgtype.Null}
+	require.NoError(t, null.AssignTo(&s))
+	require.Nil(t, s)
+}
@@ -0,0 +1,229 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgio"
+)
+
+type Int4multirange struct {
+	Ranges []Int4range
+	Status Status
+}
+
+func (dst *Int4multirange) Set(src interface{}) error {
+	// untyped nil and typed nil interfaces are different
+	if src == nil {
+		*dst = Int4multirange{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Int4multirange:
+		*dst = value
+	case *Int4multirange:
+		*dst = *value
+	case []Int4range:
+		if value == nil {
+			*dst = Int4multirange{Status: Null}
+		} else {
+			*dst = Int4multirange{Ranges: value, Status: Present}
+		}
+	case []*Int4range:
+		if value == nil {
+			*dst = Int4multirange{Status: Null}
+		} else {
+			ranges := make([]Int4range, len(value))
+			for i := range value {
+				if value[i] == nil {
+					return fmt.Errorf("cannot convert nil element of %v to Int4multirange", src)
+				}
+				ranges[i] = *value[i]
+			}
+			*dst = Int4multirange{Ranges: ranges, Status: Present}
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	default:
+		return fmt.Errorf("cannot convert %v to Int4multirange", src)
+	}
+
+	return nil
+}
+
+func (dst Int4multirange) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Int4multirange) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *[]Int4range:
+			*v = make([]Int4range, len(src.Ranges))
+			copy(*v, src.Ranges)
+			return nil
+		case *[]*Int4range:
+			*v = make([]*Int4range, len(src.Ranges))
+			for i := range src.Ranges {
+				r := src.Ranges[i]
+				(*v)[i] = &r
+			}
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *Int4multirange) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Int4multirange{Status: Null}
+		return nil
+	}
+
+	utmr, err := ParseUntypedTextMultirange(string(src))
+	if err != nil {
+		return err
+	}
+
+	var ranges []Int4range
+
+	if len(utmr.Elements) > 0 {
+		ranges = make([]Int4range, len(utmr.Elements))
+
+		for i, s := range utmr.Elements {
+			if err := ranges[i].DecodeText(ci, []byte(s)); err != nil {
+				return err
+			}
+		}
+	}
+
+	*dst = Int4multirange{Ranges: ranges, Status: Present}
+
+	return nil
+}
+
+func (dst *Int4multirange) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Int4multirange{Status: Null}
+		return nil
+	}
+
+	ubmr, err := ParseUntypedBinaryMultirange(src)
+	if err != nil {
+		return err
+	}
+
+	var ranges []Int4range
+
+	if len(ubmr.Elements) > 0 {
+		ranges = make([]Int4range, len(ubmr.Elements))
+
+		for i, elemSrc := range ubmr.Elements {
+			if err := ranges[i].DecodeBinary(ci, elemSrc); err != nil {
+				return err
+			}
+		}
+	}
+
+	*dst = Int4multirange{Ranges: ranges, Status: Present}
+
+	return nil
+}
+
+func (src Int4multirange) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = append(buf, '{')
+
+	for i := range src.Ranges {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+
+		var err error
+		buf, err = src.Ranges[i].EncodeText(ci, buf)
+		if err != nil {
+			return nil, err
+		} else if buf == nil {
+			return nil, fmt.Errorf("Int4multirange cannot contain NULL ranges")
+		}
+	}
+
+	buf = append(buf, '}')
+
+	return buf, nil
+}
+
+func (src Int4multirange) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = pgio.AppendInt32(buf, int32(len(src.Ranges)))
+
+	for i := range src.Ranges {
+		sp := len(buf)
+		buf = pgio.AppendInt32(buf, -1)
+
+		var err error
+		buf, err = src.Ranges[i].EncodeBinary(ci, buf)
+		if err != nil {
+			return nil, err
+		} else if buf == nil {
+			return nil, fmt.Errorf("Int4multirange cannot contain NULL ranges")
+		}
+
+		pgio.SetInt32(buf[sp:], int32(len(buf[sp:])-4))
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Int4multirange) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Int4multirange{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Int4multirange) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
@@ -0,0 +1,55 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt4multirangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int4multirange[]", []interface{}{
+		&pgtype.Int4multirangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int4multirangeArray{
+			Elements: []pgtype.Int4multirange{
+				{Status: pgtype.Present},
+				{Ranges: []pgtype.Int4range{{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 3, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}, {Lower: pgtype.Int4{Int: 5, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 7, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int4multirangeArray{Status: pgtype.Null},
+	})
+}
+
+func TestInt4multirangeArrayText(t *testing.T) {
+	src := pgtype.Int4multirangeArray{
+		Elements: []pgtype.Int4multirange{
+			{
+				Ranges: []pgtype.Int4range{
+					{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 3, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+					{Lower: pgtype.Int4{Int: 5, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 7, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				},
+				Status: pgtype.Present,
+			},
+			{Status: pgtype.Present},
+			{Status: pgtype.Null},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}
+
+	buf, err := src.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"{[1,3),[5,7)}","{}",NULL}`, string(buf))
+
+	var dst pgtype.Int4multirangeArray
+	require.NoError(t, dst.DecodeText(nil, buf))
+	require.Equal(t, src, dst)
+}
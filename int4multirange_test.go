@@ -0,0 +1,140 @@
+package pgtype_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestInt4multirangeTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int4multirange", []interface{}{
+		&pgtype.Int4multirange{Status: pgtype.Present},
+		&pgtype.Int4multirange{
+			Ranges: []pgtype.Int4range{
+				{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 3, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				{Lower: pgtype.Int4{Int: 5, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 7, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.Int4multirange{
+			Ranges: []pgtype.Int4range{
+				{Upper: pgtype.Int4{Int: -5, Status: pgtype.Present}, LowerType: pgtype.Unbounded, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				{Lower: pgtype.Int4{Int: 42, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.Int4multirange{Status: pgtype.Null},
+	})
+}
+
+func TestInt4multirangeNormalize(t *testing.T) {
+	testutil.TestSuccessfulNormalize(t, []testutil.NormalizeTest{
+		{
+			SQL: "select int4multirange(int4range(1, 5), int4range(3, 10, '(]'), int4range(20, 30))",
+			Value: pgtype.Int4multirange{
+				Ranges: []pgtype.Int4range{
+					{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 11, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+					{Lower: pgtype.Int4{Int: 20, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 30, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				},
+				Status: pgtype.Present,
+			},
+		},
+	})
+}
+
+func TestInt4multirangeSet(t *testing.T) {
+	r1 := pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 3, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}
+	r2 := pgtype.Int4range{Lower: pgtype.Int4{Int: 5, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 7, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}
+
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Int4multirange
+	}{
+		{source: nil, result: pgtype.Int4multirange{Status: pgtype.Null}},
+		{source: []pgtype.Int4range(nil), result: pgtype.Int4multirange{Status: pgtype.Null}},
+		{source: []pgtype.Int4range{}, result: pgtype.Int4multirange{Ranges: []pgtype.Int4range{}, Status: pgtype.Present}},
+		{source: []pgtype.Int4range{r1, r2}, result: pgtype.Int4multirange{Ranges: []pgtype.Int4range{r1, r2}, Status: pgtype.Present}},
+		{source: []*pgtype.Int4range{&r1, &r2}, result: pgtype.Int4multirange{Ranges: []pgtype.Int4range{r1, r2}, Status: pgtype.Present}},
+		{source: "{[1,3),[5,7)}", result: pgtype.Int4multirange{Ranges: []pgtype.Int4range{r1, r2}, Status: pgtype.Present}},
+		{source: "{}", result: pgtype.Int4multirange{Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Int4multirange
+		err := r.Set(tt.source)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(r, tt.result) {
+			t.Errorf("%d: expected %v to convert to %v, but it was %v", i, tt.source, tt.result, r)
+		}
+	}
+}
+
+func TestInt4multirangeAssignTo(t *testing.T) {
+	r1 := pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 3, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}
+	src := pgtype.Int4multirange{Ranges: []pgtype.Int4range{r1}, Status: pgtype.Present}
+
+	var ranges []pgtype.Int4range
+	err := src.AssignTo(&ranges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ranges, []pgtype.Int4range{r1}) {
+		t.Errorf("expected %v, got %v", []pgtype.Int4range{r1}, ranges)
+	}
+
+	var ptrRanges []*pgtype.Int4range
+	err = src.AssignTo(&ptrRanges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ptrRanges) != 1 || *ptrRanges[0] != r1 {
+		t.Errorf("expected %v, got %v", []*pgtype.Int4range{&r1}, ptrRanges)
+	}
+
+	nullSrc := pgtype.Int4multirange{Status: pgtype.Null}
+	ranges = []pgtype.Int4range{r1}
+	err = nullSrc.AssignTo(&ranges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ranges != nil {
+		t.Errorf("expected nil, got %v", ranges)
+	}
+}
+
+func TestInt4multirangeEncodeText(t *testing.T) {
+	src := pgtype.Int4multirange{
+		Ranges: []pgtype.Int4range{
+			{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 3, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+			{Lower: pgtype.Int4{Int: 5, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present},
+		},
+		Status: pgtype.Present,
+	}
+
+	buf, err := src.EncodeText(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "{[1,3),[5,)}" {
+		t.Errorf("expected %s, got %s", "{[1,3),[5,)}", buf)
+	}
+
+	buf, err = src.EncodeBinary(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst pgtype.Int4multirange
+	err = dst.DecodeBinary(nil, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dst, src) {
+		t.Errorf("expected %v, got %v", src, dst)
+	}
+}
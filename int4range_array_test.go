@@ -0,0 +1,29 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestInt4rangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int4range[]", []interface{}{
+		&pgtype.Int4rangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int4rangeArray{
+			Elements: []pgtype.Int4range{
+				{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Status: pgtype.Present},
+				{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 4, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int4rangeArray{Status: pgtype.Null},
+	})
+}
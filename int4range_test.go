@@ -1,6 +1,7 @@
 package pgtype_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/matthewpi/pgtype"
@@ -26,3 +27,182 @@ func TestInt4rangeNormalize(t *testing.T) {
 		},
 	})
 }
+
+func TestInt4rangeSet(t *testing.T) {
+	type inclusivityRange struct {
+		Lower          int32
+		Upper          int32
+		LowerInclusive bool
+		UpperInclusive bool
+	}
+	type boundTypeRange struct {
+		Lower     int64
+		Upper     int64
+		LowerType pgtype.BoundType
+		UpperType pgtype.BoundType
+	}
+	type ptrRange struct {
+		Lower *int32
+		Upper *int32
+		Empty bool
+	}
+
+	one, ten := int32(1), int32(10)
+
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Int4range
+	}{
+		{
+			source: "[1,10)",
+			result: pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+		},
+		{
+			source: [2]int64{1, 10},
+			result: pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+		},
+		{
+			source: [2]*int32{nil, &ten},
+			result: pgtype.Int4range{Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Unbounded, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+		},
+		{
+			source: inclusivityRange{Lower: 1, Upper: 10, LowerInclusive: false, UpperInclusive: true},
+			result: pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Exclusive, UpperType: pgtype.Inclusive, Status: pgtype.Present},
+		},
+		{
+			source: &boundTypeRange{Lower: 1, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded},
+			result: pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present},
+		},
+		{
+			source: boundTypeRange{LowerType: pgtype.Empty, UpperType: pgtype.Empty},
+			result: pgtype.Int4range{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Status: pgtype.Present},
+		},
+		{
+			source: ptrRange{Lower: &one},
+			result: pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present},
+		},
+		{
+			source: ptrRange{Empty: true},
+			result: pgtype.Int4range{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Status: pgtype.Present},
+		},
+		{
+			source: (*ptrRange)(nil),
+			result: pgtype.Int4range{Status: pgtype.Null},
+		},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Int4range
+		err := r.Set(tt.source)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+
+		if r != tt.result {
+			t.Errorf("%d: expected %v to convert to %v, but it was %v", i, tt.source, tt.result, r)
+		}
+	}
+
+	errorTests := []interface{}{
+		[3]int32{1, 2, 3},
+		struct{ Lower int32 }{Lower: 1},
+		boundTypeRange{Lower: 1, Upper: 2, LowerType: pgtype.BoundType('x')},
+	}
+
+	for i, src := range errorTests {
+		var r pgtype.Int4range
+		err := r.Set(src)
+		if err == nil {
+			t.Errorf("%d: expected error converting %v, but it was %v", i, src, r)
+		}
+	}
+}
+
+func TestInt4rangeAssignTo(t *testing.T) {
+	type inclusivityRange struct {
+		Lower          int32
+		Upper          int32
+		LowerInclusive bool
+		UpperInclusive bool
+	}
+	type boundTypeRange struct {
+		Lower     int64
+		Upper     int64
+		LowerType pgtype.BoundType
+		UpperType pgtype.BoundType
+	}
+	type ptrRange struct {
+		Lower *int32
+		Upper *int32
+		Empty bool
+	}
+	type plainRange struct {
+		Lower int
+		Upper int
+	}
+
+	one, ten := int32(1), int32(10)
+
+	canonical := pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}
+	closed := pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, Upper: pgtype.Int4{Int: 10, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Inclusive, Status: pgtype.Present}
+	lowerOnly := pgtype.Int4range{Lower: pgtype.Int4{Int: 1, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present}
+	empty := pgtype.Int4range{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Status: pgtype.Present}
+
+	var array [2]int64
+	var ptrArray [2]*int32
+	var ir inclusivityRange
+	var btr boundTypeRange
+	var pr ptrRange
+	var plain plainRange
+	var pplain *plainRange
+
+	simpleTests := []struct {
+		src      pgtype.Int4range
+		dst      interface{}
+		expected interface{}
+	}{
+		{src: canonical, dst: &array, expected: [2]int64{1, 10}},
+		{src: lowerOnly, dst: &ptrArray, expected: [2]*int32{&one, nil}},
+		{src: canonical, dst: &plain, expected: plainRange{Lower: 1, Upper: 10}},
+		{src: canonical, dst: &pplain, expected: &plainRange{Lower: 1, Upper: 10}},
+		{src: pgtype.Int4range{Status: pgtype.Null}, dst: &pplain, expected: (*plainRange)(nil)},
+		{src: closed, dst: &ir, expected: inclusivityRange{Lower: 1, Upper: 10, LowerInclusive: true, UpperInclusive: true}},
+		{src: closed, dst: &btr, expected: boundTypeRange{Lower: 1, Upper: 10, LowerType: pgtype.Inclusive, UpperType: pgtype.Inclusive}},
+		{src: lowerOnly, dst: &btr, expected: boundTypeRange{Lower: 1, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded}},
+		{src: empty, dst: &btr, expected: boundTypeRange{LowerType: pgtype.Empty, UpperType: pgtype.Empty}},
+		{src: lowerOnly, dst: &pr, expected: ptrRange{Lower: &one}},
+		{src: canonical, dst: &pr, expected: ptrRange{Lower: &one, Upper: &ten}},
+		{src: empty, dst: &pr, expected: ptrRange{Empty: true}},
+	}
+
+	for i, tt := range simpleTests {
+		err := tt.src.AssignTo(tt.dst)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+
+		if dst := reflect.ValueOf(tt.dst).Elem().Interface(); !reflect.DeepEqual(dst, tt.expected) {
+			t.Errorf("%d: expected %v to assign %v, but result was %v", i, tt.src, tt.expected, dst)
+		}
+	}
+
+	errorTests := []struct {
+		src pgtype.Int4range
+		dst interface{}
+	}{
+		{src: closed, dst: &array},
+		{src: closed, dst: &plain},
+		{src: lowerOnly, dst: &array},
+		{src: lowerOnly, dst: &plain},
+		{src: empty, dst: &array},
+		{src: empty, dst: &plain},
+		{src: pgtype.Int4range{Status: pgtype.Null}, dst: &plain},
+	}
+
+	for i, tt := range errorTests {
+		err := tt.src.AssignTo(tt.dst)
+		if err == nil {
+			t.Errorf("%d: expected error but none was returned (%v -> %v)", i, tt.src, tt.dst)
+		}
+	}
+}
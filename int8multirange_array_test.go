@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestInt8multirangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int8multirange[]", []interface{}{
+		&pgtype.Int8multirangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int8multirangeArray{
+			Elements: []pgtype.Int8multirange{
+				{Status: pgtype.Present},
+				{Ranges: []pgtype.Int8range{{Upper: pgtype.Int8{Int: -5, Status: pgtype.Present}, LowerType: pgtype.Unbounded, UpperType: pgtype.Exclusive, Status: pgtype.Present}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int8multirangeArray{Status: pgtype.Null},
+	})
+}
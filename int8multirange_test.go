@@ -0,0 +1,22 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestInt8multirangeTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int8multirange", []interface{}{
+		&pgtype.Int8multirange{Status: pgtype.Present},
+		&pgtype.Int8multirange{
+			Ranges: []pgtype.Int8range{
+				{Lower: pgtype.Int8{Int: -42, Status: pgtype.Present}, Upper: pgtype.Int8{Int: -5, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				{Lower: pgtype.Int8{Int: 1, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Status: pgtype.Present},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.Int8multirange{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestInt8rangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "int8range[]", []interface{}{
+		&pgtype.Int8rangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int8rangeArray{
+			Elements: []pgtype.Int8range{
+				{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Status: pgtype.Present},
+				{Lower: pgtype.Int8{Int: -42, Status: pgtype.Present}, Upper: pgtype.Int8{Int: -5, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.Int8rangeArray{Status: pgtype.Null},
+	})
+}
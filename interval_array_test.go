@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestIntervalArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "interval[]", []interface{}{
+		&pgtype.IntervalArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.IntervalArray{
+			Elements: []pgtype.Interval{
+				{Microseconds: 1, Days: 2, Months: 3, Status: pgtype.Present},
+				{Microseconds: -1, Days: -2, Months: -3, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.IntervalArray{Status: pgtype.Null},
+	})
+}
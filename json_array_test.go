@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestJSONArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "json[]", []interface{}{
+		&pgtype.JSONArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.JSONArray{
+			Elements: []pgtype.JSON{
+				{Bytes: []byte(`{"foo":"bar"}`), Status: pgtype.Present},
+				{Bytes: []byte(`[1, 2, 3]`), Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.JSONArray{Status: pgtype.Null},
+	})
+}
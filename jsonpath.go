@@ -0,0 +1,944 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// JSONPath represents a PostgreSQL jsonpath value.
+//
+// Set validates the syntax of a path given as a string with ValidateJSONPath so that invalid paths are rejected before
+// they are sent to the server. Values decoded from the server are not validated.
+type JSONPath struct {
+	String string
+	Status Status
+}
+
+func (dst *JSONPath) Set(src interface{}) error {
+	if src == nil {
+		*dst = JSONPath{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case JSONPath:
+		*dst = value
+		return nil
+	case *JSONPath:
+		if value == nil {
+			*dst = JSONPath{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	if value, ok := src.(interface{ Get() interface{} }); ok {
+		value2 := value.Get()
+		if value2 != value {
+			return dst.Set(value2)
+		}
+	}
+
+	switch value := src.(type) {
+	case string:
+		if err := ValidateJSONPath(value); err != nil {
+			return err
+		}
+		*dst = JSONPath{String: value, Status: Present}
+	case *string:
+		if value == nil {
+			*dst = JSONPath{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case []byte:
+		if value == nil {
+			*dst = JSONPath{Status: Null}
+		} else {
+			return dst.Set(string(value))
+		}
+	default:
+		if originalSrc, ok := underlyingStringType(src); ok {
+			return dst.Set(originalSrc)
+		}
+		return fmt.Errorf("cannot convert %v to JSONPath", value)
+	}
+
+	return nil
+}
+
+func (dst JSONPath) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *JSONPath) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *string:
+			*v = src.String
+			return nil
+		case *[]byte:
+			*v = make([]byte, len(src.String))
+			copy(*v, src.String)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (JSONPath) PreferredResultFormat() int16 {
+	return TextFormatCode
+}
+
+func (dst *JSONPath) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = JSONPath{Status: Null}
+		return nil
+	}
+
+	*dst = JSONPath{String: string(src), Status: Present}
+	return nil
+}
+
+func (dst *JSONPath) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = JSONPath{Status: Null}
+		return nil
+	}
+
+	if len(src) == 0 {
+		return fmt.Errorf("jsonpath too short")
+	}
+
+	if src[0] != 1 {
+		return fmt.Errorf("unknown jsonpath version number %d", src[0])
+	}
+
+	*dst = JSONPath{String: string(src[1:]), Status: Present}
+	return nil
+}
+
+func (JSONPath) PreferredParamFormat() int16 {
+	return TextFormatCode
+}
+
+func (src JSONPath) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return append(buf, src.String...), nil
+}
+
+func (src JSONPath) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = append(buf, 1)
+	return append(buf, src.String...), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *JSONPath) Scan(src interface{}) error {
+	if src == nil {
+		*dst = JSONPath{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		return dst.DecodeText(nil, src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src JSONPath) Value() (driver.Value, error) {
+	switch src.Status {
+	case Present:
+		return src.String, nil
+	case Null:
+		return nil, nil
+	default:
+		return nil, errUndefined
+	}
+}
+
+// ValidateJSONPath checks that path is a syntactically valid jsonpath. It follows the grammar of the PostgreSQL
+// jsonpath input function including the checks that @ is only used in filter expressions and last is only used in
+// array subscripts. It does not check like_regex patterns or datetime templates.
+func ValidateJSONPath(path string) error {
+	tokens, err := lexJSONPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid jsonpath %q: %w", path, err)
+	}
+
+	p := &jsonPathParser{tokens: tokens}
+	if err := p.parse(); err != nil {
+		return fmt.Errorf("invalid jsonpath %q: %w", path, err)
+	}
+
+	return nil
+}
+
+type jsonPathTokenKind int
+
+const (
+	jsonPathEOF jsonPathTokenKind = iota
+	jsonPathIdent
+	jsonPathString
+	jsonPathNumber
+	jsonPathVariable
+	jsonPathPunct
+)
+
+type jsonPathToken struct {
+	kind jsonPathTokenKind
+	text string
+	pos  int
+}
+
+// jsonPathSpecialChars are the characters that end an unquoted key or keyword.
+const jsonPathSpecialChars = "?%$.[]{}()|&!=<>@#,*:-+/\\\" \t\n\r\f"
+
+// jsonPathPuncts are the operators and delimiters. Two character operators are listed first so they are matched
+// before their prefixes.
+var jsonPathPuncts = []string{"==", "!=", "<>", "<=", ">=", "&&", "||", "**", ".", "?", "(", ")", "[", "]", "{", "}", ",", "*", "+", "-", "/", "%", "<", ">", "!", "@"}
+
+func isJSONPathKeyChar(b byte) bool {
+	return strings.IndexByte(jsonPathSpecialChars, b) == -1
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func lexJSONPath(s string) ([]jsonPathToken, error) {
+	var tokens []jsonPathToken
+
+	for i := 0; i < len(s); {
+		b := s[i]
+		start := i
+
+		switch {
+		case b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f':
+			i++
+			continue
+		case b == '"':
+			end, err := scanJSONPathString(s, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, jsonPathToken{kind: jsonPathString, text: s[start:end], pos: start})
+			i = end
+		case b == '$':
+			i++
+			if i < len(s) && s[i] == '"' {
+				end, err := scanJSONPathString(s, i)
+				if err != nil {
+					return nil, err
+				}
+				i = end
+			} else {
+				for i < len(s) && isJSONPathKeyChar(s[i]) {
+					i++
+				}
+			}
+			kind := jsonPathVariable
+			if i-start == 1 {
+				kind = jsonPathPunct
+			}
+			tokens = append(tokens, jsonPathToken{kind: kind, text: s[start:i], pos: start})
+		case isDigit(b) || (b == '.' && i+1 < len(s) && isDigit(s[i+1])):
+			i = scanJSONPathNumber(s, i)
+			if i < len(s) && isJSONPathKeyChar(s[i]) {
+				return nil, fmt.Errorf("trailing junk after numeric literal at position %d", start)
+			}
+			tokens = append(tokens, jsonPathToken{kind: jsonPathNumber, text: s[start:i], pos: start})
+		case isJSONPathKeyChar(b):
+			for i < len(s) && isJSONPathKeyChar(s[i]) {
+				i++
+			}
+			tokens = append(tokens, jsonPathToken{kind: jsonPathIdent, text: s[start:i], pos: start})
+		default:
+			var punct string
+			for _, p := range jsonPathPuncts {
+				if strings.HasPrefix(s[i:], p) {
+					punct = p
+					break
+				}
+			}
+			if punct == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", b, i)
+			}
+			i += len(punct)
+			tokens = append(tokens, jsonPathToken{kind: jsonPathPunct, text: punct, pos: start})
+		}
+	}
+
+	return append(tokens, jsonPathToken{kind: jsonPathEOF, pos: len(s)}), nil
+}
+
+// scanJSONPathString returns the position after the double quoted string that starts at s[start].
+func scanJSONPathString(s string, start int) (int, error) {
+	isHex := func(b byte) bool {
+		_, ok := fromHexChar(b)
+		return ok
+	}
+	hexRun := func(i, max int) int {
+		n := 0
+		for i+n < len(s) && n < max && isHex(s[i+n]) {
+			n++
+		}
+		return n
+	}
+
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return i + 1, nil
+		case '\\':
+			i++
+			if i >= len(s) {
+				break
+			}
+			switch s[i] {
+			case 'x':
+				n := hexRun(i+1, 2)
+				if n == 0 {
+					return 0, fmt.Errorf("invalid hexadecimal character sequence at position %d", i-1)
+				}
+				i += n
+			case 'u':
+				if i+1 < len(s) && s[i+1] == '{' {
+					n := hexRun(i+2, 6)
+					if n == 0 || i+2+n >= len(s) || s[i+2+n] != '}' {
+						return 0, fmt.Errorf("invalid Unicode escape sequence at position %d", i-1)
+					}
+					i += n + 2
+				} else {
+					if hexRun(i+1, 4) != 4 {
+						return 0, fmt.Errorf("invalid Unicode escape sequence at position %d", i-1)
+					}
+					i += 4
+				}
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated quoted string at position %d", start)
+}
+
+// scanJSONPathNumber returns the position after the numeric literal that starts at s[start]. It accepts decimal
+// integers and numbers with a fraction and exponent as well as hexadecimal, octal, and binary integers. Underscores may
+// separate digits.
+func scanJSONPathNumber(s string, start int) int {
+	i := start
+
+	if s[i] == '0' && i+2 < len(s) {
+		switch s[i+1] {
+		case 'x', 'X', 'o', 'O', 'b', 'B':
+			j := i + 2
+			for j < len(s) && (isJSONPathKeyChar(s[j]) && s[j] != '.') {
+				j++
+			}
+			return j
+		}
+	}
+
+	digits := func() {
+		for i < len(s) && (isDigit(s[i]) || (s[i] == '_' && i+1 < len(s) && isDigit(s[i+1]))) {
+			i++
+		}
+	}
+
+	digits()
+	if i < len(s) && s[i] == '.' {
+		i++
+		digits()
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			i = j
+			digits()
+		}
+	}
+
+	return i
+}
+
+// jsonPathMethods are the item methods that may follow a '.' and be called with '()'.
+var jsonPathMethods = map[string]struct{}{
+	"abs":          {},
+	"bigint":       {},
+	"boolean":      {},
+	"ceiling":      {},
+	"date":         {},
+	"datetime":     {},
+	"decimal":      {},
+	"double":       {},
+	"floor":        {},
+	"integer":      {},
+	"keyvalue":     {},
+	"number":       {},
+	"size":         {},
+	"string":       {},
+	"time":         {},
+	"time_tz":      {},
+	"timestamp":    {},
+	"timestamp_tz": {},
+	"type":         {},
+}
+
+// jsonPathParser is a recursive descent parser for jsonpath. Each parse method reports whether what it parsed is a
+// predicate (such as a comparison) or an expression (such as a path or arithmetic) because the grammar only allows
+// one or the other in most places.
+type jsonPathParser struct {
+	tokens      []jsonPathToken
+	pos         int
+	filterDepth int
+	arrayDepth  int
+}
+
+func (p *jsonPathParser) peek() jsonPathToken {
+	return p.tokens[p.pos]
+}
+
+func (p *jsonPathParser) next() jsonPathToken {
+	t := p.tokens[p.pos]
+	if t.kind != jsonPathEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *jsonPathParser) isPunct(text string) bool {
+	t := p.peek()
+	return t.kind == jsonPathPunct && t.text == text
+}
+
+func (p *jsonPathParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == jsonPathIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (p *jsonPathParser) errorAt(t jsonPathToken) error {
+	if t.kind == jsonPathEOF {
+		return fmt.Errorf("syntax error at end of jsonpath input")
+	}
+	return fmt.Errorf("syntax error at or near %q at position %d", t.text, t.pos)
+}
+
+func (p *jsonPathParser) expectPunct(text string) error {
+	if !p.isPunct(text) {
+		return p.errorAt(p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *jsonPathParser) expectExpression(isPredicate bool, t jsonPathToken) error {
+	if isPredicate {
+		return p.errorAt(t)
+	}
+	return nil
+}
+
+func (p *jsonPathParser) expectPredicate(isPredicate bool, t jsonPathToken) error {
+	if !isPredicate {
+		return p.errorAt(t)
+	}
+	return nil
+}
+
+func (p *jsonPathParser) parse() error {
+	if p.isKeyword("strict") || p.isKeyword("lax") {
+		p.next()
+	}
+
+	if _, err := p.parseOr(); err != nil {
+		return err
+	}
+
+	if t := p.peek(); t.kind != jsonPathEOF {
+		return p.errorAt(t)
+	}
+
+	return nil
+}
+
+func (p *jsonPathParser) parseOr() (bool, error) {
+	start := p.peek()
+	isPredicate, err := p.parseAnd()
+	if err != nil {
+		return false, err
+	}
+
+	for p.isPunct("||") {
+		op := p.next()
+		if err := p.expectPredicate(isPredicate, start); err != nil {
+			return false, err
+		}
+		start = p.peek()
+		right, err := p.parseAnd()
+		if err != nil {
+			return false, err
+		}
+		if err := p.expectPredicate(right, op); err != nil {
+			return false, err
+		}
+	}
+
+	return isPredicate, nil
+}
+
+func (p *jsonPathParser) parseAnd() (bool, error) {
+	start := p.peek()
+	isPredicate, err := p.parseNot()
+	if err != nil {
+		return false, err
+	}
+
+	for p.isPunct("&&") {
+		op := p.next()
+		if err := p.expectPredicate(isPredicate, start); err != nil {
+			return false, err
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return false, err
+		}
+		if err := p.expectPredicate(right, op); err != nil {
+			return false, err
+		}
+	}
+
+	return isPredicate, nil
+}
+
+func (p *jsonPathParser) parseNot() (bool, error) {
+	if !p.isPunct("!") {
+		return p.parseComparison()
+	}
+	p.next()
+
+	// ! only applies to a parenthesized predicate or exists.
+	t := p.peek()
+	switch {
+	case t.kind == jsonPathPunct && t.text == "(":
+		p.next()
+		isPredicate, err := p.parseOr()
+		if err != nil {
+			return false, err
+		}
+		if err := p.expectPredicate(isPredicate, t); err != nil {
+			return false, err
+		}
+		if err := p.expectPunct(")"); err != nil {
+			return false, err
+		}
+	case p.isKeyword("exists"):
+		if err := p.parseExists(); err != nil {
+			return false, err
+		}
+	default:
+		return false, p.errorAt(t)
+	}
+
+	return true, nil
+}
+
+func (p *jsonPathParser) parseComparison() (bool, error) {
+	start := p.peek()
+	isPredicate, err := p.parseAdditive()
+	if err != nil {
+		return false, err
+	}
+
+	t := p.peek()
+	switch {
+	case t.kind == jsonPathPunct && (t.text == "==" || t.text == "!=" || t.text == "<>" || t.text == "<" || t.text == "<=" || t.text == ">" || t.text == ">="):
+		p.next()
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+		right := p.peek()
+		rightIsPredicate, err := p.parseAdditive()
+		if err != nil {
+			return false, err
+		}
+		if err := p.expectExpression(rightIsPredicate, right); err != nil {
+			return false, err
+		}
+		return true, nil
+	case p.isKeyword("starts"):
+		p.next()
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+		if !p.isKeyword("with") {
+			return false, p.errorAt(p.peek())
+		}
+		p.next()
+		if initial := p.next(); initial.kind != jsonPathString && initial.kind != jsonPathVariable {
+			return false, p.errorAt(initial)
+		}
+		return true, nil
+	case p.isKeyword("like_regex"):
+		p.next()
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+		if pattern := p.next(); pattern.kind != jsonPathString {
+			return false, p.errorAt(pattern)
+		}
+		if p.isKeyword("flag") {
+			p.next()
+			flags := p.next()
+			if flags.kind != jsonPathString {
+				return false, p.errorAt(flags)
+			}
+			for _, f := range flags.text[1 : len(flags.text)-1] {
+				if !strings.ContainsRune("ismxq", f) {
+					return false, fmt.Errorf("invalid input syntax for type jsonpath: unrecognized flag character %q in like_regex predicate", f)
+				}
+			}
+		}
+		return true, nil
+	}
+
+	return isPredicate, nil
+}
+
+func (p *jsonPathParser) parseAdditive() (bool, error) {
+	start := p.peek()
+	isPredicate, err := p.parseMultiplicative()
+	if err != nil {
+		return false, err
+	}
+
+	for p.isPunct("+") || p.isPunct("-") {
+		p.next()
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+		start = p.peek()
+		isPredicate, err = p.parseMultiplicative()
+		if err != nil {
+			return false, err
+		}
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+	}
+
+	return isPredicate, nil
+}
+
+func (p *jsonPathParser) parseMultiplicative() (bool, error) {
+	start := p.peek()
+	isPredicate, err := p.parseUnary()
+	if err != nil {
+		return false, err
+	}
+
+	for p.isPunct("*") || p.isPunct("/") || p.isPunct("%") {
+		p.next()
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+		start = p.peek()
+		isPredicate, err = p.parseUnary()
+		if err != nil {
+			return false, err
+		}
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return false, err
+		}
+	}
+
+	return isPredicate, nil
+}
+
+func (p *jsonPathParser) parseUnary() (bool, error) {
+	if p.isPunct("+") || p.isPunct("-") {
+		p.next()
+		start := p.peek()
+		isPredicate, err := p.parseUnary()
+		if err != nil {
+			return false, err
+		}
+		return false, p.expectExpression(isPredicate, start)
+	}
+
+	return p.parseAccessorExpression()
+}
+
+func (p *jsonPathParser) parseAccessorExpression() (bool, error) {
+	isPredicate, err := p.parsePrimary()
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		switch {
+		case p.isPunct("."):
+			p.next()
+			if err := p.parseMemberAccessor(); err != nil {
+				return false, err
+			}
+		case p.isPunct("["):
+			p.next()
+			if err := p.parseArrayAccessor(); err != nil {
+				return false, err
+			}
+		case p.isPunct("?"):
+			p.next()
+			if err := p.parseFilter(); err != nil {
+				return false, err
+			}
+		default:
+			return isPredicate, nil
+		}
+
+		// An accessor applied to a parenthesized predicate makes it an expression.
+		isPredicate = false
+	}
+}
+
+func (p *jsonPathParser) parsePrimary() (bool, error) {
+	t := p.next()
+
+	switch t.kind {
+	case jsonPathString, jsonPathNumber, jsonPathVariable:
+		return false, nil
+	case jsonPathIdent:
+		switch strings.ToLower(t.text) {
+		case "true", "false", "null":
+			return false, nil
+		case "last":
+			if p.arrayDepth == 0 {
+				return false, fmt.Errorf("LAST is allowed only in array subscripts")
+			}
+			return false, nil
+		case "exists":
+			p.pos--
+			return true, p.parseExists()
+		}
+	case jsonPathPunct:
+		switch t.text {
+		case "$":
+			return false, nil
+		case "@":
+			if p.filterDepth == 0 {
+				return false, fmt.Errorf("@ is not allowed in root expressions")
+			}
+			return false, nil
+		case "(":
+			isPredicate, err := p.parseOr()
+			if err != nil {
+				return false, err
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return false, err
+			}
+			if isPredicate && p.isKeyword("is") {
+				p.next()
+				if !p.isKeyword("unknown") {
+					return false, p.errorAt(p.peek())
+				}
+				p.next()
+			}
+			return isPredicate, nil
+		}
+	}
+
+	return false, p.errorAt(t)
+}
+
+func (p *jsonPathParser) parseExists() error {
+	p.next() // exists
+	if err := p.expectPunct("("); err != nil {
+		return err
+	}
+	start := p.peek()
+	isPredicate, err := p.parseOr()
+	if err != nil {
+		return err
+	}
+	if err := p.expectExpression(isPredicate, start); err != nil {
+		return err
+	}
+	return p.expectPunct(")")
+}
+
+func (p *jsonPathParser) parseMemberAccessor() error {
+	t := p.next()
+
+	switch {
+	case t.kind == jsonPathPunct && t.text == "*":
+		return nil
+	case t.kind == jsonPathPunct && t.text == "**":
+		if !p.isPunct("{") {
+			return nil
+		}
+		p.next()
+		if err := p.parseAnyPathLevel(); err != nil {
+			return err
+		}
+		if p.isKeyword("to") {
+			p.next()
+			if err := p.parseAnyPathLevel(); err != nil {
+				return err
+			}
+		}
+		return p.expectPunct("}")
+	case t.kind == jsonPathString:
+		return nil
+	case t.kind == jsonPathIdent:
+		if !p.isPunct("(") {
+			return nil
+		}
+		if _, ok := jsonPathMethods[strings.ToLower(t.text)]; !ok {
+			return fmt.Errorf("unknown jsonpath method %q at position %d", t.text, t.pos)
+		}
+		p.next()
+		return p.parseMethodArguments()
+	}
+
+	return p.errorAt(t)
+}
+
+func (p *jsonPathParser) parseAnyPathLevel() error {
+	t := p.next()
+	if t.kind == jsonPathIdent && strings.EqualFold(t.text, "last") {
+		return nil
+	}
+	if t.kind == jsonPathNumber {
+		for i := 0; i < len(t.text); i++ {
+			if !isDigit(t.text[i]) && t.text[i] != '_' {
+				return p.errorAt(t)
+			}
+		}
+		return nil
+	}
+	return p.errorAt(t)
+}
+
+// parseMethodArguments parses the arguments of methods such as datetime(template) and decimal(precision, scale).
+func (p *jsonPathParser) parseMethodArguments() error {
+	if p.isPunct(")") {
+		p.next()
+		return nil
+	}
+
+	for {
+		if p.isPunct("+") || p.isPunct("-") {
+			p.next()
+			if t := p.next(); t.kind != jsonPathNumber {
+				return p.errorAt(t)
+			}
+		} else if t := p.next(); t.kind != jsonPathNumber && t.kind != jsonPathString {
+			return p.errorAt(t)
+		}
+
+		if !p.isPunct(",") {
+			break
+		}
+		p.next()
+	}
+
+	return p.expectPunct(")")
+}
+
+func (p *jsonPathParser) parseArrayAccessor() error {
+	if p.isPunct("*") {
+		p.next()
+		return p.expectPunct("]")
+	}
+
+	p.arrayDepth++
+	defer func() { p.arrayDepth-- }()
+
+	for {
+		start := p.peek()
+		isPredicate, err := p.parseOr()
+		if err != nil {
+			return err
+		}
+		if err := p.expectExpression(isPredicate, start); err != nil {
+			return err
+		}
+
+		if p.isKeyword("to") {
+			p.next()
+			start := p.peek()
+			isPredicate, err := p.parseOr()
+			if err != nil {
+				return err
+			}
+			if err := p.expectExpression(isPredicate, start); err != nil {
+				return err
+			}
+		}
+
+		if !p.isPunct(",") {
+			break
+		}
+		p.next()
+	}
+
+	return p.expectPunct("]")
+}
+
+func (p *jsonPathParser) parseFilter() error {
+	open := p.peek()
+	if err := p.expectPunct("("); err != nil {
+		return err
+	}
+
+	p.filterDepth++
+	defer func() { p.filterDepth-- }()
+
+	isPredicate, err := p.parseOr()
+	if err != nil {
+		return err
+	}
+	if err := p.expectPredicate(isPredicate, open); err != nil {
+		return err
+	}
+
+	return p.expectPunct(")")
+}
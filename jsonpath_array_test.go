@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestJSONPathArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "jsonpath[]", []interface{}{
+		&pgtype.JSONPathArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.JSONPathArray{
+			Elements: []pgtype.JSONPath{
+				{String: `$."a"`, Status: pgtype.Present},
+				{String: `$."a"[*]?(@ > 1)`, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.JSONPathArray{Status: pgtype.Null},
+	})
+}
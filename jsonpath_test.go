@@ -0,0 +1,125 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPathTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "jsonpath", []interface{}{
+		&pgtype.JSONPath{String: "$", Status: pgtype.Present},
+		&pgtype.JSONPath{String: `$."a"[*]?(@ > 2)`, Status: pgtype.Present},
+		&pgtype.JSONPath{Status: pgtype.Null},
+	})
+}
+
+func TestJSONPathBinary(t *testing.T) {
+	buf, err := pgtype.JSONPath{String: "$.a", Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("\x01$.a"), buf)
+
+	var dst pgtype.JSONPath
+	require.NoError(t, dst.DecodeBinary(nil, buf))
+	require.Equal(t, pgtype.JSONPath{String: "$.a", Status: pgtype.Present}, dst)
+
+	require.Error(t, dst.DecodeBinary(nil, []byte{}))
+	require.Error(t, dst.DecodeBinary(nil, []byte("\x02$.a")))
+}
+
+func TestJSONPathSet(t *testing.T) {
+	var dst pgtype.JSONPath
+	require.NoError(t, dst.Set("$.a"))
+	require.Equal(t, pgtype.JSONPath{String: "$.a", Status: pgtype.Present}, dst)
+
+	require.NoError(t, dst.Set((*string)(nil)))
+	require.Equal(t, pgtype.JSONPath{Status: pgtype.Null}, dst)
+
+	require.Error(t, dst.Set("$.a ? (@.b"))
+}
+
+func TestValidateJSONPath(t *testing.T) {
+	validPaths := []string{
+		"$",
+		"$.a",
+		"strict $.a.b",
+		"lax $.a[*]",
+		`$."key with spaces"`,
+		"$.a.b.c[0]",
+		"$[0 to 2, 5]",
+		"$[last]",
+		"$[last - 1]",
+		"$[$.size() - 1]",
+		"$.*",
+		"$.**",
+		"$.**{2}",
+		"$.**{1 to last}",
+		"$.a ? (@ > 2)",
+		"$.a ? (@.b == \"x\" && @.c != 1 || !(@.d < 0))",
+		"$.a ? (exists (@.b))",
+		"$.a ? (!exists (@.b))",
+		"$.a ? ((@ > 1) is unknown)",
+		"$.a ? (@ starts with \"abc\")",
+		"$.a ? (@ starts with $prefix)",
+		"$.a ? (@ like_regex \"^ab.*c\" flag \"i\")",
+		"$.a ? (@ like_regex \"^ab\")",
+		"$.a.type()",
+		"$.a.size() > 2",
+		"$.a.datetime(\"YYYY-MM-DD\")",
+		"$.a.decimal(4, 2)",
+		"$.a.keyvalue().key",
+		"-$.a + 2 * 3 / 4 % 5",
+		"($.a + 1).type()",
+		"$ == 1",
+		"1 + 2",
+		"$var",
+		`$"my var".a`,
+		"true",
+		"null",
+		"1.5e3",
+		".5",
+		"\"\\u0041\\u{1F600}\\x41\"",
+		"$.exists",
+		"$.last",
+		"$ ? (@.a[*] > $min && @.a[*] < $max)",
+	}
+
+	for i, path := range validPaths {
+		require.NoErrorf(t, pgtype.ValidateJSONPath(path), "%d: %s", i, path)
+	}
+
+	invalidPaths := []string{
+		"",
+		"strict",
+		"$.",
+		"$.a[",
+		"$.a[1",
+		"$.a ? (@ > 2",
+		"$.a ? (@.b)",
+		"$.a ? (@ > 1) is unknown",
+		"@",
+		"@.a",
+		"last",
+		"$.a[*] && $.b",
+		"$.a == ",
+		"$ == 1 == 2",
+		"!$.a",
+		"$.a.unknownmethod()",
+		"$.a ? (@ like_regex \"x\" flag \"z\")",
+		"$.a ? (@ starts with 1)",
+		"$.**{-1}",
+		"$.a ? ((@ > 1) is known)",
+		"1a",
+		"\"unterminated",
+		"\"\\uZZZZ\"",
+		"$ # 1",
+		"$ = 1",
+		"exists ($ > 1)",
+	}
+
+	for i, path := range invalidPaths {
+		require.Errorf(t, pgtype.ValidateJSONPath(path), "%d: %s", i, path)
+	}
+}
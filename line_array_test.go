@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestLineArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "line[]", []interface{}{
+		&pgtype.LineArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.LineArray{
+			Elements: []pgtype.Line{
+				{A: 1.23, B: 4.56, C: 7.89012345, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.LineArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestLsegArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "lseg[]", []interface{}{
+		&pgtype.LsegArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.LsegArray{
+			Elements: []pgtype.Lseg{
+				{P: [2]pgtype.Vec2{{3.14, 1.678}, {7.1, 5.2345678901}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.LsegArray{Status: pgtype.Null},
+	})
+}
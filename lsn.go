@@ -0,0 +1,260 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// LSN represents a PostgreSQL pg_lsn value. A pg_lsn is a position in the write-ahead log. It is stored as a uint64 and
+// formatted as two hexadecimal numbers of up to 8 digits each separated by a slash such as 16/B374D848.
+type LSN struct {
+	Uint   uint64
+	Status Status
+}
+
+func (dst *LSN) Set(src interface{}) error {
+	if src == nil {
+		*dst = LSN{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case LSN:
+		*dst = value
+	case *LSN:
+		if value == nil {
+			*dst = LSN{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case uint64:
+		*dst = LSN{Uint: value, Status: Present}
+	case *uint64:
+		if value == nil {
+			*dst = LSN{Status: Null}
+		} else {
+			*dst = LSN{Uint: *value, Status: Present}
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = LSN{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to LSN", value)
+	}
+
+	return nil
+}
+
+func (dst LSN) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *LSN) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *uint64:
+			*v = src.Uint
+			return nil
+		case *string:
+			*v = string(appendLSN(nil, src.Uint))
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+// Compare returns -1, 0, or 1 if src is before, the same as, or after other. Status is not considered.
+func (src LSN) Compare(other LSN) int {
+	switch {
+	case src.Uint < other.Uint:
+		return -1
+	case src.Uint > other.Uint:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Sub returns the number of bytes from other to src. It is equivalent to the PostgreSQL pg_lsn - pg_lsn operator. An
+// error is returned if src or other is not present or the result does not fit in an int64.
+func (src LSN) Sub(other LSN) (int64, error) {
+	if src.Status != Present || other.Status != Present {
+		return 0, fmt.Errorf("cannot subtract LSN that is not present")
+	}
+
+	if src.Uint >= other.Uint {
+		diff := src.Uint - other.Uint
+		if diff > math.MaxInt64 {
+			return 0, fmt.Errorf("LSN difference out of range")
+		}
+		return int64(diff), nil
+	}
+
+	diff := other.Uint - src.Uint
+	if diff > -math.MinInt64 {
+		return 0, fmt.Errorf("LSN difference out of range")
+	}
+	return int64(-diff), nil
+}
+
+// Add returns src advanced by n bytes. n may be negative. It is equivalent to the PostgreSQL pg_lsn + numeric operator.
+// An error is returned if src is not present or the result is out of range.
+func (src LSN) Add(n int64) (LSN, error) {
+	if src.Status != Present {
+		return LSN{}, fmt.Errorf("cannot add to LSN that is not present")
+	}
+
+	if n >= 0 {
+		if math.MaxUint64-src.Uint < uint64(n) {
+			return LSN{}, fmt.Errorf("LSN out of range")
+		}
+		return LSN{Uint: src.Uint + uint64(n), Status: Present}, nil
+	}
+
+	// -n overflows for math.MinInt64 but the conversion to uint64 gives the correct magnitude.
+	magnitude := uint64(-n)
+	if src.Uint < magnitude {
+		return LSN{}, fmt.Errorf("LSN out of range")
+	}
+	return LSN{Uint: src.Uint - magnitude, Status: Present}, nil
+}
+
+func (dst *LSN) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = LSN{Status: Null}
+		return nil
+	}
+
+	n, err := parseLSN(string(src))
+	if err != nil {
+		return err
+	}
+
+	*dst = LSN{Uint: n, Status: Present}
+	return nil
+}
+
+// parseLSN parses the same format as the PostgreSQL pg_lsn input function.
+func parseLSN(s string) (uint64, error) {
+	slash := strings.IndexByte(s, '/')
+	if slash == -1 {
+		return 0, fmt.Errorf("invalid input syntax for type pg_lsn: %q", s)
+	}
+
+	hi, lo := s[:slash], s[slash+1:]
+	if len(hi) == 0 || len(hi) > 8 || len(lo) == 0 || len(lo) > 8 || !isHexString(hi) || !isHexString(lo) {
+		return 0, fmt.Errorf("invalid input syntax for type pg_lsn: %q", s)
+	}
+
+	hiN, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid input syntax for type pg_lsn: %q", s)
+	}
+	loN, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid input syntax for type pg_lsn: %q", s)
+	}
+
+	return hiN<<32 | loN, nil
+}
+
+func isHexString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if _, ok := fromHexChar(s[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// appendLSN appends n in the same format as the PostgreSQL pg_lsn output function.
+func appendLSN(buf []byte, n uint64) []byte {
+	return append(buf, fmt.Sprintf("%X/%X", uint32(n>>32), uint32(n))...)
+}
+
+func (dst *LSN) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = LSN{Status: Null}
+		return nil
+	}
+
+	if len(src) != 8 {
+		return fmt.Errorf("invalid length for pg_lsn: %v", len(src))
+	}
+
+	*dst = LSN{Uint: binary.BigEndian.Uint64(src), Status: Present}
+	return nil
+}
+
+func (src LSN) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return appendLSN(buf, src.Uint), nil
+}
+
+func (src LSN) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return pgio.AppendUint64(buf, src.Uint), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *LSN) Scan(src interface{}) error {
+	if src == nil {
+		*dst = LSN{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src LSN) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestLSNArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "pg_lsn[]", []interface{}{
+		&pgtype.LSNArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.LSNArray{
+			Elements: []pgtype.LSN{
+				{Uint: 0x16B374D848, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.LSNArray{Status: pgtype.Null},
+	})
+}
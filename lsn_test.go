@@ -0,0 +1,163 @@
+package pgtype_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLSNTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "pg_lsn", []interface{}{
+		&pgtype.LSN{Uint: 0, Status: pgtype.Present},
+		&pgtype.LSN{Uint: 0x16B374D848, Status: pgtype.Present},
+		&pgtype.LSN{Uint: math.MaxUint64, Status: pgtype.Present},
+		&pgtype.LSN{Status: pgtype.Null},
+	})
+}
+
+func TestLSNDecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result uint64
+	}{
+		{source: "0/0", result: 0},
+		{source: "16/B374D848", result: 0x16B374D848},
+		{source: "16/b374d848", result: 0x16B374D848},
+		{source: "0/0000000A", result: 10},
+		{source: "FFFFFFFF/FFFFFFFF", result: math.MaxUint64},
+	}
+
+	for i, tt := range successfulTests {
+		var lsn pgtype.LSN
+		err := lsn.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, pgtype.LSN{Uint: tt.result, Status: pgtype.Present}, lsn, "%d: %s", i, tt.source)
+	}
+
+	for i, source := range []string{"", "/", "0/", "/0", "16B374D848", "G/0", "0/-1", "+1/0", "1/2/3", "100000000/0", "0/100000000", " 0/0"} {
+		var lsn pgtype.LSN
+		err := lsn.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestLSNEncodeText(t *testing.T) {
+	tests := []struct {
+		source uint64
+		result string
+	}{
+		{source: 0, result: "0/0"},
+		{source: 10, result: "0/A"},
+		{source: 0x16B374D848, result: "16/B374D848"},
+		{source: 0x100000000, result: "1/0"},
+		{source: math.MaxUint64, result: "FFFFFFFF/FFFFFFFF"},
+	}
+
+	for i, tt := range tests {
+		buf, err := pgtype.LSN{Uint: tt.source, Status: pgtype.Present}.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+}
+
+func TestLSNSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.LSN
+	}{
+		{source: uint64(42), result: pgtype.LSN{Uint: 42, Status: pgtype.Present}},
+		{source: "16/B374D848", result: pgtype.LSN{Uint: 0x16B374D848, Status: pgtype.Present}},
+		{source: (*uint64)(nil), result: pgtype.LSN{Status: pgtype.Null}},
+		{source: nil, result: pgtype.LSN{Status: pgtype.Null}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.LSN
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+}
+
+func TestLSNAssignTo(t *testing.T) {
+	src := pgtype.LSN{Uint: 0x16B374D848, Status: pgtype.Present}
+
+	var n uint64
+	require.NoError(t, src.AssignTo(&n))
+	require.Equal(t, uint64(0x16B374D848), n)
+
+	var s string
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, "16/B374D848", s)
+
+	var pn *uint64
+	require.NoError(t, src.AssignTo(&pn))
+	require.Equal(t, uint64(0x16B374D848), *pn)
+
+	null := pgtype.LSN{Status: pgtype.Null}
+	require.NoError(t, null.AssignTo(&pn))
+	require.Nil(t, pn)
+}
+
+func TestLSNCompare(t *testing.T) {
+	a := pgtype.LSN{Uint: 1, Status: pgtype.Present}
+	b := pgtype.LSN{Uint: 2, Status: pgtype.Present}
+
+	require.Equal(t, -1, a.Compare(b))
+	require.Equal(t, 1, b.Compare(a))
+	require.Equal(t, 0, a.Compare(a))
+}
+
+func TestLSNSub(t *testing.T) {
+	a := pgtype.LSN{Uint: 0x16B374D848, Status: pgtype.Present}
+	b := pgtype.LSN{Uint: 0x16B3748000, Status: pgtype.Present}
+
+	n, err := a.Sub(b)
+	require.NoError(t, err)
+	require.Equal(t, int64(0x5848), n)
+
+	n, err = b.Sub(a)
+	require.NoError(t, err)
+	require.Equal(t, int64(-0x5848), n)
+
+	min := pgtype.LSN{Uint: 0, Status: pgtype.Present}
+	max := pgtype.LSN{Uint: math.MaxUint64, Status: pgtype.Present}
+	_, err = max.Sub(min)
+	require.Error(t, err)
+	_, err = min.Sub(max)
+	require.Error(t, err)
+
+	_, err = a.Sub(pgtype.LSN{Status: pgtype.Null})
+	require.Error(t, err)
+}
+
+func TestLSNAdd(t *testing.T) {
+	a := pgtype.LSN{Uint: 0x16B374D848, Status: pgtype.Present}
+
+	r, err := a.Add(16)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.LSN{Uint: 0x16B374D858, Status: pgtype.Present}, r)
+
+	r, err = a.Add(-0x16B374D848)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.LSN{Uint: 0, Status: pgtype.Present}, r)
+
+	_, err = a.Add(-0x16B374D849)
+	require.Error(t, err)
+
+	_, err = a.Add(math.MinInt64)
+	require.Error(t, err)
+
+	_, err = pgtype.LSN{Uint: math.MaxUint64, Status: pgtype.Present}.Add(1)
+	require.Error(t, err)
+
+	r, err = pgtype.LSN{Uint: math.MaxUint64, Status: pgtype.Present}.Add(math.MinInt64)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.LSN{Uint: math.MaxUint64 - 1<<63, Status: pgtype.Present}, r)
+
+	_, err = pgtype.LSN{Status: pgtype.Null}.Add(1)
+	require.Error(t, err)
+}
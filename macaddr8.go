@@ -0,0 +1,289 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Macaddr8 represents a PostgreSQL macaddr8 (EUI-64) value. As with PostgreSQL a 6 byte MAC address is converted to 8
+// bytes by inserting FF:FE in the middle.
+type Macaddr8 struct {
+	Addr   net.HardwareAddr
+	Status Status
+}
+
+// macaddrToMacaddr8 converts a 6 byte MAC address to EUI-64 the same way PostgreSQL does.
+func macaddrToMacaddr8(addr net.HardwareAddr) net.HardwareAddr {
+	return net.HardwareAddr{addr[0], addr[1], addr[2], 0xff, 0xfe, addr[3], addr[4], addr[5]}
+}
+
+// normalizeMacaddr8 returns a copy of addr as 8 bytes.
+func normalizeMacaddr8(addr net.HardwareAddr) (net.HardwareAddr, error) {
+	switch len(addr) {
+	case 6:
+		return macaddrToMacaddr8(addr), nil
+	case 8:
+		result := make(net.HardwareAddr, 8)
+		copy(result, addr)
+		return result, nil
+	default:
+		return nil, fmt.Errorf("invalid length for macaddr8: %d", len(addr))
+	}
+}
+
+func (dst *Macaddr8) Set(src interface{}) error {
+	if src == nil {
+		*dst = Macaddr8{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Macaddr8:
+		*dst = value
+	case *Macaddr8:
+		if value == nil {
+			*dst = Macaddr8{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case Macaddr:
+		if value.Status != Present {
+			*dst = Macaddr8{Status: value.Status}
+			return nil
+		}
+		return dst.Set(value.Addr)
+	case net.HardwareAddr:
+		if value == nil {
+			*dst = Macaddr8{Status: Null}
+			return nil
+		}
+		addr, err := normalizeMacaddr8(value)
+		if err != nil {
+			return err
+		}
+		*dst = Macaddr8{Addr: addr, Status: Present}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *net.HardwareAddr:
+		if value == nil {
+			*dst = Macaddr8{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case *string:
+		if value == nil {
+			*dst = Macaddr8{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	default:
+		if originalSrc, ok := underlyingPtrType(src); ok {
+			return dst.Set(originalSrc)
+		}
+		return fmt.Errorf("cannot convert %v to Macaddr8", value)
+	}
+
+	return nil
+}
+
+func (dst Macaddr8) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst.Addr
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns src to dst. Assigning to a Macaddr requires that src was converted from a 6 byte address (i.e. the
+// fourth and fifth bytes are FF:FE) like the PostgreSQL cast from macaddr8 to macaddr.
+func (src *Macaddr8) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *net.HardwareAddr:
+			*v = make(net.HardwareAddr, len(src.Addr))
+			copy(*v, src.Addr)
+			return nil
+		case *string:
+			*v = src.Addr.String()
+			return nil
+		case *Macaddr:
+			if len(src.Addr) != 8 || src.Addr[3] != 0xff || src.Addr[4] != 0xfe {
+				return fmt.Errorf("cannot convert %v to Macaddr: only addresses that have FF and FE as values in the 4th and 5th bytes from the left can be converted", src.Addr)
+			}
+			*v = Macaddr{
+				Addr:   net.HardwareAddr{src.Addr[0], src.Addr[1], src.Addr[2], src.Addr[5], src.Addr[6], src.Addr[7]},
+				Status: Present,
+			}
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *Macaddr8) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Macaddr8{Status: Null}
+		return nil
+	}
+
+	addr, err := parseMacaddr8(string(src))
+	if err != nil {
+		return err
+	}
+
+	*dst = Macaddr8{Addr: addr, Status: Present}
+	return nil
+}
+
+// parseMacaddr8 parses the same formats as the PostgreSQL macaddr8 input function. The address is pairs of hex digits
+// optionally separated by ':', '-', or '.'. All separators must be the same character. A 6 byte address is converted
+// to 8 bytes.
+func parseMacaddr8(s string) (net.HardwareAddr, error) {
+	str := strings.TrimSpace(s)
+
+	addr := make(net.HardwareAddr, 0, 8)
+	var spacer byte
+
+	for i := 0; i < len(str); {
+		if len(addr) == 8 {
+			return nil, fmt.Errorf("invalid input syntax for type macaddr8: %q", s)
+		}
+		if i+2 > len(str) {
+			return nil, fmt.Errorf("invalid input syntax for type macaddr8: %q", s)
+		}
+
+		hi, ok1 := fromHexChar(str[i])
+		lo, ok2 := fromHexChar(str[i+1])
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("invalid input syntax for type macaddr8: %q", s)
+		}
+		addr = append(addr, hi<<4|lo)
+		i += 2
+
+		if i < len(str) {
+			switch b := str[i]; b {
+			case ':', '-', '.':
+				if spacer == 0 {
+					spacer = b
+				} else if spacer != b {
+					return nil, fmt.Errorf("invalid input syntax for type macaddr8: %q", s)
+				}
+				i++
+				if i == len(str) {
+					return nil, fmt.Errorf("invalid input syntax for type macaddr8: %q", s)
+				}
+			}
+		}
+	}
+
+	switch len(addr) {
+	case 6:
+		return macaddrToMacaddr8(addr), nil
+	case 8:
+		return addr, nil
+	default:
+		return nil, fmt.Errorf("invalid input syntax for type macaddr8: %q", s)
+	}
+}
+
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+
+	return 0, false
+}
+
+func (dst *Macaddr8) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Macaddr8{Status: Null}
+		return nil
+	}
+
+	if len(src) != 8 {
+		return fmt.Errorf("Received an invalid size for a macaddr8: %d", len(src))
+	}
+
+	addr := make(net.HardwareAddr, 8)
+	copy(addr, src)
+
+	*dst = Macaddr8{Addr: addr, Status: Present}
+
+	return nil
+}
+
+func (src Macaddr8) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	addr, err := normalizeMacaddr8(src.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buf, addr.String()...), nil
+}
+
+// EncodeBinary encodes src into w. A 6 byte address is converted to 8 bytes.
+func (src Macaddr8) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	addr, err := normalizeMacaddr8(src.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buf, addr...), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Macaddr8) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Macaddr8{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Macaddr8) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestMacaddr8ArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "macaddr8[]", []interface{}{
+		&pgtype.Macaddr8Array{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.Macaddr8Array{
+			Elements: []pgtype.Macaddr8{
+				{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab:cd:ef"), Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.Macaddr8Array{Status: pgtype.Null},
+	})
+}
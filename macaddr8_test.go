@@ -0,0 +1,133 @@
+package pgtype_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMacaddr8Transcode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "macaddr8", []interface{}{
+		&pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab:cd:ef"), Status: pgtype.Present},
+		&pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:ff:fe:67:89:ab"), Status: pgtype.Present},
+		&pgtype.Macaddr8{Status: pgtype.Null},
+	})
+}
+
+func TestMacaddr8DecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result string
+	}{
+		{source: "08:00:2b:01:02:03:04:05", result: "08:00:2b:01:02:03:04:05"},
+		{source: "08-00-2b-01-02-03-04-05", result: "08:00:2b:01:02:03:04:05"},
+		{source: "08002b:0102030405", result: "08:00:2b:01:02:03:04:05"},
+		{source: "08002b-0102030405", result: "08:00:2b:01:02:03:04:05"},
+		{source: "0800.2b01.0203.0405", result: "08:00:2b:01:02:03:04:05"},
+		{source: "0800-2b01-0203-0405", result: "08:00:2b:01:02:03:04:05"},
+		{source: "08002b01:02030405", result: "08:00:2b:01:02:03:04:05"},
+		{source: "08002B0102030405", result: "08:00:2b:01:02:03:04:05"},
+		{source: " 08:00:2b:01:02:03:04:05 ", result: "08:00:2b:01:02:03:04:05"},
+		{source: "08:00:2b:01:02:03", result: "08:00:2b:ff:fe:01:02:03"},
+		{source: "08002b010203", result: "08:00:2b:ff:fe:01:02:03"},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Macaddr8
+		err := r.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, tt.result, r.Addr.String(), "%d: %s", i, tt.source)
+	}
+
+	errorTests := []string{
+		"",
+		"08:00:2b:01:02",
+		"08:00:2b:01:02:03:04",
+		"08:00:2b:01:02:03:04:05:06",
+		"08:00-2b:01:02:03:04:05",
+		"08:00:2b:01:02:03:04:05:",
+		"0:00:2b:01:02:03:04:05",
+		"zz:00:2b:01:02:03:04:05",
+	}
+
+	for i, source := range errorTests {
+		var r pgtype.Macaddr8
+		err := r.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestMacaddr8Set(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Macaddr8
+	}{
+		{
+			source: mustParseMacaddr(t, "01:23:45:67:89:ab:cd:ef"),
+			result: pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab:cd:ef"), Status: pgtype.Present},
+		},
+		{
+			source: mustParseMacaddr(t, "01:23:45:67:89:ab"),
+			result: pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:ff:fe:67:89:ab"), Status: pgtype.Present},
+		},
+		{
+			source: pgtype.Macaddr{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab"), Status: pgtype.Present},
+			result: pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:ff:fe:67:89:ab"), Status: pgtype.Present},
+		},
+		{
+			source: "01:23:45:67:89:ab:cd:ef",
+			result: pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab:cd:ef"), Status: pgtype.Present},
+		},
+		{source: (*net.HardwareAddr)(nil), result: pgtype.Macaddr8{Status: pgtype.Null}},
+		{source: nil, result: pgtype.Macaddr8{Status: pgtype.Null}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Macaddr8
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	var r pgtype.Macaddr8
+	err := r.Set(net.HardwareAddr{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestMacaddr8AssignTo(t *testing.T) {
+	src := pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:ff:fe:67:89:ab"), Status: pgtype.Present}
+
+	var s string
+	err := src.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, "01:23:45:ff:fe:67:89:ab", s)
+
+	var addr net.HardwareAddr
+	err = src.AssignTo(&addr)
+	require.NoError(t, err)
+	require.Equal(t, src.Addr, addr)
+
+	var macaddr pgtype.Macaddr
+	err = src.AssignTo(&macaddr)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Macaddr{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab"), Status: pgtype.Present}, macaddr)
+
+	src = pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab:cd:ef"), Status: pgtype.Present}
+	err = src.AssignTo(&macaddr)
+	require.Error(t, err)
+}
+
+func TestMacaddr8EncodeSixByteAddr(t *testing.T) {
+	src := pgtype.Macaddr8{Addr: mustParseMacaddr(t, "01:23:45:67:89:ab"), Status: pgtype.Present}
+
+	buf, err := src.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "01:23:45:ff:fe:67:89:ab", string(buf))
+
+	buf, err = src.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x23, 0x45, 0xff, 0xfe, 0x67, 0x89, 0xab}, buf)
+}
@@ -0,0 +1,288 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// moneyScale is the number of fractional digits of a Money value. PostgreSQL stores money as an int64 count of the
+// smallest currency unit. The number of fractional digits depends on lc_monetary, but it is 2 for nearly all locales
+// and for the C locale. Money always assumes 2.
+const moneyScale = 2
+
+// Money represents a PostgreSQL money value. Int is the amount in the smallest currency unit (e.g. cents). The binary
+// format is independent of the server's lc_monetary setting and is preferred. The text format depends on lc_monetary,
+// so DecodeText ignores currency symbols and digit grouping and EncodeText writes a plain decimal number such as
+// -1234.56.
+type Money struct {
+	Int    int64
+	Status Status
+}
+
+func (dst *Money) Set(src interface{}) error {
+	if src == nil {
+		*dst = Money{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Money:
+		*dst = value
+	case *Money:
+		if value == nil {
+			*dst = Money{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case int64:
+		*dst = Money{Int: value, Status: Present}
+	case *int64:
+		if value == nil {
+			*dst = Money{Status: Null}
+		} else {
+			*dst = Money{Int: *value, Status: Present}
+		}
+	case Numeric:
+		return dst.setNumeric(&value)
+	case *Numeric:
+		if value == nil {
+			*dst = Money{Status: Null}
+		} else {
+			return dst.setNumeric(value)
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = Money{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to Money", value)
+	}
+
+	return nil
+}
+
+func (dst *Money) setNumeric(src *Numeric) error {
+	switch src.Status {
+	case Null:
+		*dst = Money{Status: Null}
+		return nil
+	case Undefined:
+		return fmt.Errorf("cannot convert undefined Numeric to Money")
+	}
+
+	if src.NaN {
+		return fmt.Errorf("cannot convert NaN to Money")
+	}
+
+	cents := Numeric{Int: src.Int, Exp: src.Exp + moneyScale, Status: Present}
+	n, err := cents.toBigInt()
+	if err != nil {
+		return fmt.Errorf("cannot convert %v to Money without losing precision", src)
+	}
+	if !n.IsInt64() {
+		return fmt.Errorf("%v is out of range for Money", src)
+	}
+
+	*dst = Money{Int: n.Int64(), Status: Present}
+	return nil
+}
+
+func (dst Money) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns src to dst. An int64 destination receives the amount in the smallest currency unit.
+func (src *Money) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *int64:
+			*v = src.Int
+			return nil
+		case *Numeric:
+			*v = Numeric{Int: big.NewInt(src.Int), Exp: -moneyScale, Status: Present}
+			return nil
+		case *string:
+			*v = string(appendMoneyText(nil, src.Int))
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *Money) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Money{Status: Null}
+		return nil
+	}
+
+	n, err := parseMoney(string(src))
+	if err != nil {
+		return err
+	}
+
+	*dst = Money{Int: n, Status: Present}
+	return nil
+}
+
+// parseMoney parses the text format of money. Currency symbols, white space, and digit grouping are ignored. A value
+// is negative if it contains a minus sign or is enclosed in parentheses. The last '.' or ',' is the decimal separator
+// unless it is followed by exactly three digits in which case it is a grouping separator.
+func parseMoney(src string) (int64, error) {
+	negative := strings.ContainsAny(src, "-(")
+
+	var digits []byte
+	decimalPos := -1
+	lastSeparator := -1
+	for i := 0; i < len(src); i++ {
+		switch b := src[i]; {
+		case b >= '0' && b <= '9':
+			digits = append(digits, b)
+		case b == '.' || b == ',':
+			lastSeparator = len(digits)
+		}
+	}
+
+	if len(digits) == 0 {
+		return 0, fmt.Errorf("invalid money: %q", src)
+	}
+
+	if lastSeparator != -1 && len(digits)-lastSeparator != 3 {
+		decimalPos = lastSeparator
+	}
+
+	fracDigits := 0
+	if decimalPos != -1 {
+		fracDigits = len(digits) - decimalPos
+	}
+	if fracDigits > moneyScale {
+		return 0, fmt.Errorf("invalid money: %q has more than %d fractional digits", src, moneyScale)
+	}
+	for ; fracDigits < moneyScale; fracDigits++ {
+		digits = append(digits, '0')
+	}
+
+	u, err := strconv.ParseUint(string(digits), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid money: %q is out of range", src)
+	}
+
+	if negative {
+		if u > -math.MinInt64 {
+			return 0, fmt.Errorf("invalid money: %q is out of range", src)
+		}
+		return int64(-u), nil
+	}
+
+	if u > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid money: %q is out of range", src)
+	}
+	return int64(u), nil
+}
+
+func (dst *Money) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Money{Status: Null}
+		return nil
+	}
+
+	if len(src) != 8 {
+		return fmt.Errorf("invalid length for money: %v", len(src))
+	}
+
+	*dst = Money{Int: int64(binary.BigEndian.Uint64(src)), Status: Present}
+	return nil
+}
+
+func (src Money) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return appendMoneyText(buf, src.Int), nil
+}
+
+// appendMoneyText appends n formatted as a decimal number with moneyScale fractional digits.
+func appendMoneyText(buf []byte, n int64) []byte {
+	u := uint64(n)
+	if n < 0 {
+		buf = append(buf, '-')
+		u = -u
+	}
+
+	s := strconv.FormatUint(u, 10)
+	if len(s) <= moneyScale {
+		s = strings.Repeat("0", moneyScale-len(s)+1) + s
+	}
+
+	buf = append(buf, s[:len(s)-moneyScale]...)
+	buf = append(buf, '.')
+	return append(buf, s[len(s)-moneyScale:]...)
+}
+
+func (src Money) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return pgio.AppendInt64(buf, src.Int), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Money) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Money{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case int64:
+		*dst = Money{Int: src, Status: Present}
+		return nil
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Money) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
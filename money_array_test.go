@@ -0,0 +1,45 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "money[]", []interface{}{
+		&pgtype.MoneyArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.MoneyArray{
+			Elements: []pgtype.Money{
+				{Int: 123456, Status: pgtype.Present},
+				{Status: pgtype.Null},
+				{Int: -1, Status: pgtype.Present},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.MoneyArray{Status: pgtype.Null},
+	})
+}
+
+func TestMoneyArraySetAndAssignTo(t *testing.T) {
+	var a pgtype.MoneyArray
+	err := a.Set([]int64{100, -250})
+	require.NoError(t, err)
+
+	var s []string
+	err = a.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.00", "-2.50"}, s)
+
+	var i64s []int64
+	err = a.AssignTo(&i64s)
+	require.NoError(t, err)
+	require.Equal(t, []int64{100, -250}, i64s)
+}
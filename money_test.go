@@ -0,0 +1,151 @@
+package pgtype_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "money", []interface{}{
+		&pgtype.Money{Int: 0, Status: pgtype.Present},
+		&pgtype.Money{Int: 123456, Status: pgtype.Present},
+		&pgtype.Money{Int: -5, Status: pgtype.Present},
+		&pgtype.Money{Int: math.MaxInt64, Status: pgtype.Present},
+		&pgtype.Money{Int: math.MinInt64, Status: pgtype.Present},
+		&pgtype.Money{Status: pgtype.Null},
+	})
+}
+
+func TestMoneyDecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result int64
+	}{
+		{source: "$1,234.56", result: 123456},
+		{source: "-$1,234.56", result: -123456},
+		{source: "($1,234.56)", result: -123456},
+		{source: "1.234,56 €", result: 123456},
+		{source: "-1 234,56 €", result: -123456},
+		{source: "1234.5", result: 123450},
+		{source: "$0.05", result: 5},
+		{source: "¥1,234", result: 123400},
+		{source: "12", result: 1200},
+		{source: "$92,233,720,368,547,758.07", result: math.MaxInt64},
+		{source: "-$92,233,720,368,547,758.08", result: math.MinInt64},
+	}
+
+	for i, tt := range successfulTests {
+		var m pgtype.Money
+		err := m.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, pgtype.Money{Int: tt.result, Status: pgtype.Present}, m, "%d: %s", i, tt.source)
+	}
+
+	for i, source := range []string{"", "$", "1.2345", "$92,233,720,368,547,758.08", "-$92,233,720,368,547,758.09"} {
+		var m pgtype.Money
+		err := m.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestMoneyEncodeText(t *testing.T) {
+	tests := []struct {
+		source int64
+		result string
+	}{
+		{source: 0, result: "0.00"},
+		{source: 5, result: "0.05"},
+		{source: -5, result: "-0.05"},
+		{source: 123456, result: "1234.56"},
+		{source: -123456, result: "-1234.56"},
+		{source: math.MinInt64, result: "-92233720368547758.08"},
+	}
+
+	for i, tt := range tests {
+		buf, err := pgtype.Money{Int: tt.source, Status: pgtype.Present}.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+}
+
+func TestMoneySet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Money
+	}{
+		{source: int64(123), result: pgtype.Money{Int: 123, Status: pgtype.Present}},
+		{source: "$1.23", result: pgtype.Money{Int: 123, Status: pgtype.Present}},
+		{source: pgtype.Numeric{Int: big.NewInt(123), Exp: -2, Status: pgtype.Present}, result: pgtype.Money{Int: 123, Status: pgtype.Present}},
+		{source: &pgtype.Numeric{Int: big.NewInt(12300), Exp: -4, Status: pgtype.Present}, result: pgtype.Money{Int: 123, Status: pgtype.Present}},
+		{source: pgtype.Numeric{Int: big.NewInt(5), Exp: 1, Status: pgtype.Present}, result: pgtype.Money{Int: 5000, Status: pgtype.Present}},
+		{source: (*int64)(nil), result: pgtype.Money{Status: pgtype.Null}},
+		{source: nil, result: pgtype.Money{Status: pgtype.Null}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Money
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	errorTests := []interface{}{
+		pgtype.Numeric{Int: big.NewInt(1234), Exp: -3, Status: pgtype.Present},
+		pgtype.Numeric{NaN: true, Status: pgtype.Present},
+		pgtype.Numeric{Int: big.NewInt(1), Exp: 30, Status: pgtype.Present},
+		1.5,
+	}
+
+	for i, source := range errorTests {
+		var r pgtype.Money
+		err := r.Set(source)
+		require.Errorf(t, err, "%d", i)
+	}
+}
+
+func TestMoneyAssignTo(t *testing.T) {
+	m := pgtype.Money{Int: -123456, Status: pgtype.Present}
+
+	var i64 int64
+	err := m.AssignTo(&i64)
+	require.NoError(t, err)
+	require.Equal(t, int64(-123456), i64)
+
+	var n pgtype.Numeric
+	err = m.AssignTo(&n)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Numeric{Int: big.NewInt(-123456), Exp: -2, Status: pgtype.Present}, n)
+
+	var s string
+	err = m.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, "-1234.56", s)
+
+	var ps *string
+	err = m.AssignTo(&ps)
+	require.NoError(t, err)
+	require.Equal(t, "-1234.56", *ps)
+
+	null := pgtype.Money{Status: pgtype.Null}
+	var pi64 *int64
+	err = null.AssignTo(&pi64)
+	require.NoError(t, err)
+	require.Nil(t, pi64)
+}
+
+func TestMoneyBinaryRoundTrip(t *testing.T) {
+	m := pgtype.Money{Int: -123456, Status: pgtype.Present}
+	buf, err := m.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, buf, 8)
+
+	var r pgtype.Money
+	err = r.DecodeBinary(nil, buf)
+	require.NoError(t, err)
+	require.Equal(t, m, r)
+}
@@ -0,0 +1,162 @@
+package pgtype
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+type UntypedTextMultirange struct {
+	Elements []string
+}
+
+func ParseUntypedTextMultirange(src string) (*UntypedTextMultirange, error) {
+	utmr := &UntypedTextMultirange{}
+
+	buf := bytes.NewBufferString(src)
+
+	skipWhitespace(buf)
+
+	r, _, err := buf.ReadRune()
+	if err != nil {
+		return nil, fmt.Errorf("invalid multirange: %v", err)
+	}
+	if r != '{' {
+		return nil, fmt.Errorf("invalid multirange, expected '{' but got: %v", string(r))
+	}
+
+	skipWhitespace(buf)
+
+	r, _, err = buf.ReadRune()
+	if err != nil {
+		return nil, fmt.Errorf("invalid multirange: %v", err)
+	}
+
+	if r != '}' {
+		buf.UnreadRune()
+
+		for {
+			skipWhitespace(buf)
+
+			value, err := multirangeParseRange(buf)
+			if err != nil {
+				return nil, fmt.Errorf("invalid multirange value: %v", err)
+			}
+			utmr.Elements = append(utmr.Elements, value)
+
+			skipWhitespace(buf)
+
+			r, _, err = buf.ReadRune()
+			if err != nil {
+				return nil, fmt.Errorf("invalid multirange: %v", err)
+			}
+
+			if r == '}' {
+				break
+			} else if r != ',' {
+				return nil, fmt.Errorf("missing multirange separator, instead got: %v", string(r))
+			}
+		}
+	}
+
+	skipWhitespace(buf)
+
+	if buf.Len() > 0 {
+		return nil, fmt.Errorf("unexpected trailing data: %v", buf.String())
+	}
+
+	return utmr, nil
+}
+
+// multirangeParseRange reads a single range literal from buf without interpreting it. The result is suitable for
+// ParseUntypedTextRange.
+func multirangeParseRange(buf *bytes.Buffer) (string, error) {
+	s := &bytes.Buffer{}
+
+	r, _, err := buf.ReadRune()
+	if err != nil {
+		return "", err
+	}
+
+	switch r {
+	case '(', '[':
+	case 'e', 'E':
+		buf.UnreadRune()
+		word := buf.Next(len("empty"))
+		if !bytes.EqualFold(word, []byte("empty")) {
+			return "", fmt.Errorf("invalid range: %s", word)
+		}
+		return "empty", nil
+	default:
+		return "", fmt.Errorf("missing lower bound, instead got: %v", string(r))
+	}
+	s.WriteRune(r)
+
+	inQuote := false
+	for {
+		r, _, err := buf.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		s.WriteRune(r)
+
+		switch r {
+		case '\\':
+			r, _, err = buf.ReadRune()
+			if err != nil {
+				return "", err
+			}
+			s.WriteRune(r)
+		case '"':
+			inQuote = !inQuote
+		case ')', ']':
+			if !inQuote {
+				return s.String(), nil
+			}
+		}
+	}
+}
+
+type UntypedBinaryMultirange struct {
+	Elements [][]byte
+}
+
+func ParseUntypedBinaryMultirange(src []byte) (*UntypedBinaryMultirange, error) {
+	ubmr := &UntypedBinaryMultirange{}
+
+	if len(src) < 4 {
+		return nil, fmt.Errorf("multirange too short: %v", len(src))
+	}
+
+	rangeCount := int(binary.BigEndian.Uint32(src))
+	rp := 4
+
+	// Every range has at least a 4 byte length. Check before allocating so a bad count can not cause a huge allocation.
+	if rangeCount*4 > len(src)-rp {
+		return nil, fmt.Errorf("invalid range count %v for multirange of length %v", rangeCount, len(src))
+	}
+
+	if rangeCount > 0 {
+		ubmr.Elements = make([][]byte, rangeCount)
+	}
+
+	for i := range ubmr.Elements {
+		if len(src[rp:]) < 4 {
+			return nil, fmt.Errorf("too few bytes for size: %v", src[rp:])
+		}
+		rangeLen := int(int32(binary.BigEndian.Uint32(src[rp:])))
+		rp += 4
+
+		if rangeLen < 0 || len(src[rp:]) < rangeLen {
+			return nil, fmt.Errorf("invalid range length: %v", rangeLen)
+		}
+		ubmr.Elements[i] = src[rp : rp+rangeLen]
+		rp += rangeLen
+	}
+
+	if len(src[rp:]) > 0 {
+		return nil, fmt.Errorf("unexpected trailing bytes parsing multirange: %v", len(src[rp:]))
+	}
+
+	return ubmr, nil
+}
@@ -0,0 +1,132 @@
+package pgtype
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseUntypedTextMultirange(t *testing.T) {
+	tests := []struct {
+		src    string
+		result UntypedTextMultirange
+		err    bool
+	}{
+		{
+			src:    `{}`,
+			result: UntypedTextMultirange{},
+		},
+		{
+			src:    ` { } `,
+			result: UntypedTextMultirange{},
+		},
+		{
+			src:    `{[1,2)}`,
+			result: UntypedTextMultirange{Elements: []string{`[1,2)`}},
+		},
+		{
+			src:    `{[1,3),[5,7)}`,
+			result: UntypedTextMultirange{Elements: []string{`[1,3)`, `[5,7)`}},
+		},
+		{
+			src:    `{(,3), [5,)}`,
+			result: UntypedTextMultirange{Elements: []string{`(,3)`, `[5,)`}},
+		},
+		{
+			src:    `{empty,[1,2]}`,
+			result: UntypedTextMultirange{Elements: []string{`empty`, `[1,2]`}},
+		},
+		{
+			src:    `{["2020-01-01 00:00:00","2020-01-02 00:00:00")}`,
+			result: UntypedTextMultirange{Elements: []string{`["2020-01-01 00:00:00","2020-01-02 00:00:00")`}},
+		},
+		{
+			src:    `{["a)","b]")}`,
+			result: UntypedTextMultirange{Elements: []string{`["a)","b]")`}},
+		},
+		{
+			src:    `{[a\),b)}`,
+			result: UntypedTextMultirange{Elements: []string{`[a\),b)`}},
+		},
+		{src: `[1,2)`, err: true},
+		{src: `{[1,2)`, err: true},
+		{src: `{[1,2)]}`, err: true},
+		{src: `{[1,2)} x`, err: true},
+	}
+
+	for i, tt := range tests {
+		r, err := ParseUntypedTextMultirange(tt.src)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%d. `%v`: expected error, got none", i, tt.src)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. `%v`: unexpected error %v", i, tt.src, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(*r, tt.result) {
+			t.Errorf("%d. `%v`: expected result %#v, got %#v", i, tt.src, tt.result, *r)
+		}
+
+		for _, e := range r.Elements {
+			if _, err := ParseUntypedTextRange(e); err != nil {
+				t.Errorf("%d. `%v`: element `%v` is not a valid range: %v", i, tt.src, e, err)
+			}
+		}
+	}
+}
+
+func TestParseUntypedBinaryMultirange(t *testing.T) {
+	tests := []struct {
+		src    []byte
+		result UntypedBinaryMultirange
+		err    bool
+	}{
+		{
+			src:    []byte{0, 0, 0, 0},
+			result: UntypedBinaryMultirange{},
+		},
+		{
+			src:    []byte{0, 0, 0, 1, 0, 0, 0, 1, 1},
+			result: UntypedBinaryMultirange{Elements: [][]byte{{1}}},
+		},
+		{
+			src:    []byte{0, 0, 0, 2, 0, 0, 0, 1, 24, 0, 0, 0, 7, 18, 0, 0, 0, 2, 0, 4},
+			result: UntypedBinaryMultirange{Elements: [][]byte{{24}, {18, 0, 0, 0, 2, 0, 4}}},
+		},
+		{src: []byte{0, 0, 0}, err: true},
+		{src: []byte{0, 0, 0, 1}, err: true},
+		{src: []byte{0, 0, 0, 1, 0, 0, 0, 2, 1}, err: true},
+		{src: []byte{0, 0, 0, 1, 0, 0, 0, 1, 1, 0}, err: true},
+		{src: []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 1, 1}, err: true},
+		{src: []byte{0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0}, err: true},
+	}
+
+	for i, tt := range tests {
+		r, err := ParseUntypedBinaryMultirange(tt.src)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%d. `%v`: expected error, got none", i, tt.src)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. `%v`: unexpected error %v", i, tt.src, err)
+			continue
+		}
+
+		if len(r.Elements) != len(tt.result.Elements) {
+			t.Errorf("%d. `%v`: expected %d elements, got %d", i, tt.src, len(tt.result.Elements), len(r.Elements))
+			continue
+		}
+
+		for j := range r.Elements {
+			if !bytes.Equal(r.Elements[j], tt.result.Elements[j]) {
+				t.Errorf("%d. `%v`: expected element %d %v, got %v", i, tt.src, j, tt.result.Elements[j], r.Elements[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestNameArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "name[]", []interface{}{
+		&pgtype.NameArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.NameArray{
+			Elements: []pgtype.Name{
+				{String: "foo", Status: pgtype.Present},
+				{String: "", Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.NameArray{Status: pgtype.Null},
+	})
+}
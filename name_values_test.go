@@ -0,0 +1,40 @@
+package pgtype
+
+import (
+	"strings"
+	"testing"
+)
+
+// typesWithoutArrays are the scalar types that PostgreSQL does not define an array type for.
+var typesWithoutArrays = map[string]struct{}{
+	"unknown": {},
+}
+
+func TestNameValuesHaveArrays(t *testing.T) {
+	for name := range nameValues {
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		if _, ok := typesWithoutArrays[name]; ok {
+			continue
+		}
+		if _, ok := nameValues["_"+name]; !ok {
+			t.Errorf("%s has no array entry in nameValues", name)
+		}
+	}
+}
+
+func TestNewConnInfoRegistersArrays(t *testing.T) {
+	ci := NewConnInfo()
+	for name := range ci.nameToDataType {
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		if _, ok := typesWithoutArrays[name]; ok {
+			continue
+		}
+		if _, ok := ci.DataTypeForName("_" + name); !ok {
+			t.Errorf("%s has no array registered by NewConnInfo", name)
+		}
+	}
+}
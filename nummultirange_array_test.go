@@ -0,0 +1,29 @@
+package pgtype_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestNummultirangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "nummultirange[]", []interface{}{
+		&pgtype.NummultirangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.NummultirangeArray{
+			Elements: []pgtype.Nummultirange{
+				{Status: pgtype.Present},
+				{Ranges: []pgtype.Numrange{{Lower: pgtype.Numeric{Int: big.NewInt(-543), Exp: 3, Status: pgtype.Present}, Upper: pgtype.Numeric{Int: big.NewInt(342), Exp: 1, Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.NummultirangeArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,34 @@
+package pgtype_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestNummultirangeTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "nummultirange", []interface{}{
+		&pgtype.Nummultirange{Status: pgtype.Present},
+		&pgtype.Nummultirange{
+			Ranges: []pgtype.Numrange{
+				{
+					Lower:     pgtype.Numeric{Int: big.NewInt(-543), Exp: 3, Status: pgtype.Present},
+					Upper:     pgtype.Numeric{Int: big.NewInt(342), Exp: 1, Status: pgtype.Present},
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Exclusive,
+					Status:    pgtype.Present,
+				},
+				{
+					Lower:     pgtype.Numeric{Int: big.NewInt(5), Exp: 4, Status: pgtype.Present},
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Unbounded,
+					Status:    pgtype.Present,
+				},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.Nummultirange{Status: pgtype.Null},
+	})
+}
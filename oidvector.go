@@ -0,0 +1,208 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// OIDVector represents a PostgreSQL oidvector value. oidvector is used in the system catalogs such as for
+// pg_proc.proargtypes. The text format is the elements separated by spaces. The binary format is the same as a one
+// dimensional oid array with a lower bound of 0 and no NULL elements.
+type OIDVector struct {
+	Elements []uint32
+	Status   Status
+}
+
+func (dst *OIDVector) Set(src interface{}) error {
+	if src == nil {
+		*dst = OIDVector{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case OIDVector:
+		*dst = value
+	case *OIDVector:
+		if value == nil {
+			*dst = OIDVector{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case []uint32:
+		if value == nil {
+			*dst = OIDVector{Status: Null}
+		} else {
+			elements := make([]uint32, len(value))
+			copy(elements, value)
+			*dst = OIDVector{Elements: elements, Status: Present}
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = OIDVector{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		if originalSrc, ok := underlyingSliceType(src); ok {
+			return dst.Set(originalSrc)
+		}
+		return fmt.Errorf("cannot convert %v to OIDVector", value)
+	}
+
+	return nil
+}
+
+func (dst OIDVector) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *OIDVector) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *[]uint32:
+			*v = make([]uint32, len(src.Elements))
+			copy(*v, src.Elements)
+			return nil
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *OIDVector) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = OIDVector{Status: Null}
+		return nil
+	}
+
+	fields := strings.Fields(string(src))
+	elements := make([]uint32, len(fields))
+	for i, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid oidvector element %q: %w", f, err)
+		}
+		elements[i] = uint32(n)
+	}
+
+	*dst = OIDVector{Elements: elements, Status: Present}
+	return nil
+}
+
+func (dst *OIDVector) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = OIDVector{Status: Null}
+		return nil
+	}
+
+	elementSrcs, err := decodeVectorBinary("oidvector", OIDOID, 4, src)
+	if err != nil {
+		return err
+	}
+
+	elements := make([]uint32, len(elementSrcs))
+	for i, elemSrc := range elementSrcs {
+		elements[i] = binary.BigEndian.Uint32(elemSrc)
+	}
+
+	*dst = OIDVector{Elements: elements, Status: Present}
+	return nil
+}
+
+func (src OIDVector) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	// An empty oidvector is an empty string, not NULL.
+	if buf == nil {
+		buf = []byte{}
+	}
+
+	for i, n := range src.Elements {
+		if i > 0 {
+			buf = append(buf, ' ')
+		}
+		buf = strconv.AppendUint(buf, uint64(n), 10)
+	}
+
+	return buf, nil
+}
+
+func (src OIDVector) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	arrayHeader := ArrayHeader{
+		ElementOID: OIDOID,
+		Dimensions: []ArrayDimension{{Length: int32(len(src.Elements)), LowerBound: 0}},
+	}
+	buf = arrayHeader.EncodeBinary(ci, buf)
+
+	for _, n := range src.Elements {
+		buf = pgio.AppendInt32(buf, 4)
+		buf = pgio.AppendUint32(buf, n)
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *OIDVector) Scan(src interface{}) error {
+	if src == nil {
+		*dst = OIDVector{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src OIDVector) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
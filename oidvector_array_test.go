@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestOIDVectorArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "oidvector[]", []interface{}{
+		&pgtype.OIDVectorArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.OIDVectorArray{
+			Elements: []pgtype.OIDVector{
+				{Elements: []uint32{}, Status: pgtype.Present},
+				{Elements: []uint32{23, 25}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.OIDVectorArray{Status: pgtype.Null},
+	})
+}
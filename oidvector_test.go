@@ -0,0 +1,64 @@
+package pgtype_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDVectorTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "oidvector", []interface{}{
+		&pgtype.OIDVector{Elements: []uint32{23}, Status: pgtype.Present},
+		&pgtype.OIDVector{Elements: []uint32{23, 25, math.MaxUint32}, Status: pgtype.Present},
+		&pgtype.OIDVector{Status: pgtype.Null},
+	})
+}
+
+func TestOIDVectorDecodeText(t *testing.T) {
+	var r pgtype.OIDVector
+	require.NoError(t, r.DecodeText(nil, []byte("23 25 4294967295")))
+	require.Equal(t, pgtype.OIDVector{Elements: []uint32{23, 25, math.MaxUint32}, Status: pgtype.Present}, r)
+
+	require.NoError(t, r.DecodeText(nil, []byte("")))
+	require.Equal(t, pgtype.OIDVector{Elements: []uint32{}, Status: pgtype.Present}, r)
+
+	for i, source := range []string{"a", "-1", "4294967296"} {
+		err := r.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestOIDVectorBinaryRoundTrip(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for i, src := range []pgtype.OIDVector{
+		{Elements: []uint32{}, Status: pgtype.Present},
+		{Elements: []uint32{23, 25, math.MaxUint32}, Status: pgtype.Present},
+	} {
+		buf, err := src.EncodeBinary(ci, nil)
+		require.NoErrorf(t, err, "%d", i)
+
+		var dst pgtype.OIDVector
+		require.NoErrorf(t, dst.DecodeBinary(ci, buf), "%d", i)
+		require.Equalf(t, src, dst, "%d", i)
+	}
+}
+
+func TestOIDVectorAssignTo(t *testing.T) {
+	src := pgtype.OIDVector{Elements: []uint32{23, 25}, Status: pgtype.Present}
+
+	var s []uint32
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, []uint32{23, 25}, s)
+
+	var str string
+	require.NoError(t, src.AssignTo(&str))
+	require.Equal(t, "23 25", str)
+
+	null := pgtype.OIDVector{Status: pgtype.Null}
+	require.NoError(t, null.AssignTo(&s))
+	require.Nil(t, s)
+}
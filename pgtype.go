@@ -8,78 +8,165 @@ import (
 	"math"
 	"net"
 	"reflect"
+	"sync"
 	"time"
+
+	"github.com/jackc/pgio"
 )
 
 // PostgreSQL oids for common types
 const (
-	BoolOID             = 16
-	ByteaOID            = 17
-	QCharOID            = 18
-	NameOID             = 19
-	Int8OID             = 20
-	Int2OID             = 21
-	Int4OID             = 23
-	TextOID             = 25
-	OIDOID              = 26
-	TIDOID              = 27
-	XIDOID              = 28
-	CIDOID              = 29
-	JSONOID             = 114
-	PointOID            = 600
-	LsegOID             = 601
-	PathOID             = 602
-	BoxOID              = 603
-	PolygonOID          = 604
-	LineOID             = 628
-	CIDROID             = 650
-	CIDRArrayOID        = 651
-	Float4OID           = 700
-	Float8OID           = 701
-	CircleOID           = 718
-	UnknownOID          = 705
-	MacaddrOID          = 829
-	InetOID             = 869
-	BoolArrayOID        = 1000
-	Int2ArrayOID        = 1005
-	Int4ArrayOID        = 1007
-	TextArrayOID        = 1009
-	ByteaArrayOID       = 1001
-	BPCharArrayOID      = 1014
-	VarcharArrayOID     = 1015
-	Int8ArrayOID        = 1016
-	Float4ArrayOID      = 1021
-	Float8ArrayOID      = 1022
-	ACLItemOID          = 1033
-	ACLItemArrayOID     = 1034
-	InetArrayOID        = 1041
-	BPCharOID           = 1042
-	VarcharOID          = 1043
-	DateOID             = 1082
-	TimeOID             = 1083
-	TimestampOID        = 1114
-	TimestampArrayOID   = 1115
-	DateArrayOID        = 1182
-	TimestamptzOID      = 1184
-	TimestamptzArrayOID = 1185
-	IntervalOID         = 1186
-	NumericArrayOID     = 1231
-	BitOID              = 1560
-	VarbitOID           = 1562
-	NumericOID          = 1700
-	RecordOID           = 2249
-	UUIDOID             = 2950
-	UUIDArrayOID        = 2951
-	JSONBOID            = 3802
-	JSONBArrayOID       = 3807
-	DaterangeOID        = 3912
-	Int4rangeOID        = 3904
-	NumrangeOID         = 3906
-	TsrangeOID          = 3908
-	TsrangeArrayOID     = 3909
-	TstzrangeOID        = 3910
-	TstzrangeArrayOID   = 3911
-	Int8rangeOID        = 3926
+	BoolOID                = 16
+	ByteaOID               = 17
+	QCharOID               = 18
+	NameOID                = 19
+	Int8OID                = 20
+	Int2OID                = 21
+	Int2VectorOID          = 22
+	Int4OID                = 23
+	RegprocOID             = 24
+	TextOID                = 25
+	OIDOID                 = 26
+	TIDOID                 = 27
+	XIDOID                 = 28
+	CIDOID                 = 29
+	OIDVectorOID           = 30
+	JSONOID                = 114
+	XMLOID                 = 142
+	XMLArrayOID            = 143
+	JSONArrayOID           = 199
+	XID8ArrayOID           = 271
+	PointOID               = 600
+	LsegOID                = 601
+	PathOID                = 602
+	BoxOID                 = 603
+	PolygonOID             = 604
+	LineOID                = 628
+	LineArrayOID           = 629
+	CIDROID                = 650
+	CIDRArrayOID           = 651
+	Float4OID              = 700
+	Float8OID              = 701
+	CircleOID              = 718
+	CircleArrayOID         = 719
+	UnknownOID             = 705
+	Macaddr8OID            = 774
+	Macaddr8ArrayOID       = 775
+	MoneyOID               = 790
+	MoneyArrayOID          = 791
+	MacaddrOID             = 829
+	InetOID                = 869
+	BoolArrayOID           = 1000
+	QCharArrayOID          = 1002
+	NameArrayOID           = 1003
+	Int2ArrayOID           = 1005
+	Int2VectorArrayOID     = 1006
+	Int4ArrayOID           = 1007
+	RegprocArrayOID        = 1008
+	TextArrayOID           = 1009
+	ByteaArrayOID          = 1001
+	TIDArrayOID            = 1010
+	XIDArrayOID            = 1011
+	CIDArrayOID            = 1012
+	OIDVectorArrayOID      = 1013
+	BPCharArrayOID         = 1014
+	VarcharArrayOID        = 1015
+	Int8ArrayOID           = 1016
+	PointArrayOID          = 1017
+	LsegArrayOID           = 1018
+	PathArrayOID           = 1019
+	BoxArrayOID            = 1020
+	Float4ArrayOID         = 1021
+	Float8ArrayOID         = 1022
+	PolygonArrayOID        = 1027
+	OIDArrayOID            = 1028
+	ACLItemOID             = 1033
+	ACLItemArrayOID        = 1034
+	MacaddrArrayOID        = 1040
+	InetArrayOID           = 1041
+	BPCharOID              = 1042
+	VarcharOID             = 1043
+	DateOID                = 1082
+	TimeOID                = 1083
+	TimestampOID           = 1114
+	TimestampArrayOID      = 1115
+	DateArrayOID           = 1182
+	TimeArrayOID           = 1183
+	TimestamptzOID         = 1184
+	TimestamptzArrayOID    = 1185
+	IntervalOID            = 1186
+	IntervalArrayOID       = 1187
+	NumericArrayOID        = 1231
+	TimetzOID              = 1266
+	TimetzArrayOID         = 1270
+	BitOID                 = 1560
+	BitArrayOID            = 1561
+	VarbitOID              = 1562
+	VarbitArrayOID         = 1563
+	NumericOID             = 1700
+	RegprocedureOID        = 2202
+	RegoperOID             = 2203
+	RegoperatorOID         = 2204
+	RegclassOID            = 2205
+	RegtypeOID             = 2206
+	RegprocedureArrayOID   = 2207
+	RegoperArrayOID        = 2208
+	RegoperatorArrayOID    = 2209
+	RegclassArrayOID       = 2210
+	RegtypeArrayOID        = 2211
+	RecordOID              = 2249
+	RecordArrayOID         = 2287
+	TxidSnapshotArrayOID   = 2949
+	UUIDOID                = 2950
+	UUIDArrayOID           = 2951
+	TxidSnapshotOID        = 2970
+	PgLSNOID               = 3220
+	PgLSNArrayOID          = 3221
+	TSVectorOID            = 3614
+	TSQueryOID             = 3615
+	TSVectorArrayOID       = 3643
+	TSQueryArrayOID        = 3645
+	RegconfigOID           = 3734
+	RegconfigArrayOID      = 3735
+	RegdictionaryOID       = 3769
+	RegdictionaryArrayOID  = 3770
+	JSONBOID               = 3802
+	JSONBArrayOID          = 3807
+	Int4rangeArrayOID      = 3905
+	NumrangeArrayOID       = 3907
+	DaterangeOID           = 3912
+	Int4rangeOID           = 3904
+	NumrangeOID            = 3906
+	TsrangeOID             = 3908
+	TsrangeArrayOID        = 3909
+	TstzrangeOID           = 3910
+	TstzrangeArrayOID      = 3911
+	DaterangeArrayOID      = 3913
+	Int8rangeOID           = 3926
+	Int8rangeArrayOID      = 3927
+	JSONPathOID            = 4072
+	JSONPathArrayOID       = 4073
+	RegnamespaceOID        = 4089
+	RegnamespaceArrayOID   = 4090
+	RegroleOID             = 4096
+	RegroleArrayOID        = 4097
+	RegcollationOID        = 4191
+	RegcollationArrayOID   = 4192
+	Int4multirangeOID      = 4451
+	NummultirangeOID       = 4532
+	TsmultirangeOID        = 4533
+	TstzmultirangeOID      = 4534
+	DatemultirangeOID      = 4535
+	Int8multirangeOID      = 4536
+	PgSnapshotOID          = 5038
+	PgSnapshotArrayOID     = 5039
+	XID8OID                = 5069
+	Int4multirangeArrayOID = 6150
+	NummultirangeArrayOID  = 6151
+	TsmultirangeArrayOID   = 6152
+	TstzmultirangeArrayOID = 6153
+	DatemultirangeArrayOID = 6155
+	Int8multirangeArrayOID = 6157
 )
 
 type Status byte
@@ -229,7 +316,15 @@ type DataType struct {
 	OID  uint32
 }
 
+// ConnInfo is a registry of data types. Registering and looking up data types is safe for concurrent use by multiple
+// goroutines. However, by default the DataTypes returned by lookups share a single Value per data type that is mutated
+// while encoding and decoding. Use Shared to get a ConnInfo that can be shared by multiple connections.
 type ConnInfo struct {
+	mu sync.RWMutex
+
+	// shared is true when lookups return DataTypes with their own Value.
+	shared bool
+
 	oidToDataType         map[uint32]*DataType
 	nameToDataType        map[string]*DataType
 	reflectTypeToName     map[reflect.Type]string
@@ -253,23 +348,78 @@ func NewConnInfo() *ConnInfo {
 	ci := newConnInfo()
 
 	ci.RegisterDataType(DataType{Value: &ACLItemArray{}, Name: "_aclitem", OID: ACLItemArrayOID})
+	ci.RegisterDataType(DataType{Value: &BitArray{}, Name: "_bit", OID: BitArrayOID})
 	ci.RegisterDataType(DataType{Value: &BoolArray{}, Name: "_bool", OID: BoolArrayOID})
+	ci.RegisterDataType(DataType{Value: &BoxArray{}, Name: "_box", OID: BoxArrayOID})
 	ci.RegisterDataType(DataType{Value: &BPCharArray{}, Name: "_bpchar", OID: BPCharArrayOID})
 	ci.RegisterDataType(DataType{Value: &ByteaArray{}, Name: "_bytea", OID: ByteaArrayOID})
+	ci.RegisterDataType(DataType{Value: &QCharArray{}, Name: "_char", OID: QCharArrayOID})
+	ci.RegisterDataType(DataType{Value: &CIDArray{}, Name: "_cid", OID: CIDArrayOID})
 	ci.RegisterDataType(DataType{Value: &CIDRArray{}, Name: "_cidr", OID: CIDRArrayOID})
+	ci.RegisterDataType(DataType{Value: &CircleArray{}, Name: "_circle", OID: CircleArrayOID})
 	ci.RegisterDataType(DataType{Value: &DateArray{}, Name: "_date", OID: DateArrayOID})
+	ci.RegisterDataType(DataType{Value: &DatemultirangeArray{}, Name: "_datemultirange", OID: DatemultirangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &DaterangeArray{}, Name: "_daterange", OID: DaterangeArrayOID})
 	ci.RegisterDataType(DataType{Value: &Float4Array{}, Name: "_float4", OID: Float4ArrayOID})
 	ci.RegisterDataType(DataType{Value: &Float8Array{}, Name: "_float8", OID: Float8ArrayOID})
 	ci.RegisterDataType(DataType{Value: &InetArray{}, Name: "_inet", OID: InetArrayOID})
 	ci.RegisterDataType(DataType{Value: &Int2Array{}, Name: "_int2", OID: Int2ArrayOID})
+	ci.RegisterDataType(DataType{Value: &Int2VectorArray{}, Name: "_int2vector", OID: Int2VectorArrayOID})
 	ci.RegisterDataType(DataType{Value: &Int4Array{}, Name: "_int4", OID: Int4ArrayOID})
+	ci.RegisterDataType(DataType{Value: &Int4multirangeArray{}, Name: "_int4multirange", OID: Int4multirangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &Int4rangeArray{}, Name: "_int4range", OID: Int4rangeArrayOID})
 	ci.RegisterDataType(DataType{Value: &Int8Array{}, Name: "_int8", OID: Int8ArrayOID})
+	ci.RegisterDataType(DataType{Value: &Int8multirangeArray{}, Name: "_int8multirange", OID: Int8multirangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &Int8rangeArray{}, Name: "_int8range", OID: Int8rangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &IntervalArray{}, Name: "_interval", OID: IntervalArrayOID})
+	ci.RegisterDataType(DataType{Value: &JSONArray{}, Name: "_json", OID: JSONArrayOID})
+	ci.RegisterDataType(DataType{Value: &JSONBArray{}, Name: "_jsonb", OID: JSONBArrayOID})
+	ci.RegisterDataType(DataType{Value: &JSONPathArray{}, Name: "_jsonpath", OID: JSONPathArrayOID})
+	ci.RegisterDataType(DataType{Value: &LineArray{}, Name: "_line", OID: LineArrayOID})
+	ci.RegisterDataType(DataType{Value: &LsegArray{}, Name: "_lseg", OID: LsegArrayOID})
+	ci.RegisterDataType(DataType{Value: &MacaddrArray{}, Name: "_macaddr", OID: MacaddrArrayOID})
+	ci.RegisterDataType(DataType{Value: &Macaddr8Array{}, Name: "_macaddr8", OID: Macaddr8ArrayOID})
+	ci.RegisterDataType(DataType{Value: &MoneyArray{}, Name: "_money", OID: MoneyArrayOID})
+	ci.RegisterDataType(DataType{Value: &NameArray{}, Name: "_name", OID: NameArrayOID})
 	ci.RegisterDataType(DataType{Value: &NumericArray{}, Name: "_numeric", OID: NumericArrayOID})
+	ci.RegisterDataType(DataType{Value: &NummultirangeArray{}, Name: "_nummultirange", OID: NummultirangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &NumrangeArray{}, Name: "_numrange", OID: NumrangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &OIDValueArray{}, Name: "_oid", OID: OIDArrayOID})
+	ci.RegisterDataType(DataType{Value: &OIDVectorArray{}, Name: "_oidvector", OID: OIDVectorArrayOID})
+	ci.RegisterDataType(DataType{Value: &PathArray{}, Name: "_path", OID: PathArrayOID})
+	ci.RegisterDataType(DataType{Value: &LSNArray{}, Name: "_pg_lsn", OID: PgLSNArrayOID})
+	ci.RegisterDataType(DataType{Value: &SnapshotArray{}, Name: "_pg_snapshot", OID: PgSnapshotArrayOID})
+	ci.RegisterDataType(DataType{Value: &PointArray{}, Name: "_point", OID: PointArrayOID})
+	ci.RegisterDataType(DataType{Value: &PolygonArray{}, Name: "_polygon", OID: PolygonArrayOID})
+	ci.RegisterDataType(DataType{Value: &RecordArray{}, Name: "_record", OID: RecordArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegclassArray{}, Name: "_regclass", OID: RegclassArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegcollationArray{}, Name: "_regcollation", OID: RegcollationArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegconfigArray{}, Name: "_regconfig", OID: RegconfigArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegdictionaryArray{}, Name: "_regdictionary", OID: RegdictionaryArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegnamespaceArray{}, Name: "_regnamespace", OID: RegnamespaceArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegoperArray{}, Name: "_regoper", OID: RegoperArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegoperatorArray{}, Name: "_regoperator", OID: RegoperatorArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegprocArray{}, Name: "_regproc", OID: RegprocArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegprocedureArray{}, Name: "_regprocedure", OID: RegprocedureArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegroleArray{}, Name: "_regrole", OID: RegroleArrayOID})
+	ci.RegisterDataType(DataType{Value: &RegtypeArray{}, Name: "_regtype", OID: RegtypeArrayOID})
 	ci.RegisterDataType(DataType{Value: &TextArray{}, Name: "_text", OID: TextArrayOID})
+	ci.RegisterDataType(DataType{Value: &TIDArray{}, Name: "_tid", OID: TIDArrayOID})
+	ci.RegisterDataType(DataType{Value: &TimeArray{}, Name: "_time", OID: TimeArrayOID})
 	ci.RegisterDataType(DataType{Value: &TimestampArray{}, Name: "_timestamp", OID: TimestampArrayOID})
 	ci.RegisterDataType(DataType{Value: &TimestamptzArray{}, Name: "_timestamptz", OID: TimestamptzArrayOID})
+	ci.RegisterDataType(DataType{Value: &TimetzArray{}, Name: "_timetz", OID: TimetzArrayOID})
+	ci.RegisterDataType(DataType{Value: &TsmultirangeArray{}, Name: "_tsmultirange", OID: TsmultirangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &TSQueryArray{}, Name: "_tsquery", OID: TSQueryArrayOID})
+	ci.RegisterDataType(DataType{Value: &TstzmultirangeArray{}, Name: "_tstzmultirange", OID: TstzmultirangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &TSVectorArray{}, Name: "_tsvector", OID: TSVectorArrayOID})
+	ci.RegisterDataType(DataType{Value: &SnapshotArray{}, Name: "_txid_snapshot", OID: TxidSnapshotArrayOID})
 	ci.RegisterDataType(DataType{Value: &UUIDArray{}, Name: "_uuid", OID: UUIDArrayOID})
+	ci.RegisterDataType(DataType{Value: &VarbitArray{}, Name: "_varbit", OID: VarbitArrayOID})
 	ci.RegisterDataType(DataType{Value: &VarcharArray{}, Name: "_varchar", OID: VarcharArrayOID})
+	ci.RegisterDataType(DataType{Value: &XIDArray{}, Name: "_xid", OID: XIDArrayOID})
+	ci.RegisterDataType(DataType{Value: &XID8Array{}, Name: "_xid8", OID: XID8ArrayOID})
+	ci.RegisterDataType(DataType{Value: &XMLArray{}, Name: "_xml", OID: XMLArrayOID})
 	ci.RegisterDataType(DataType{Value: &ACLItem{}, Name: "aclitem", OID: ACLItemOID})
 	ci.RegisterDataType(DataType{Value: &Bit{}, Name: "bit", OID: BitOID})
 	ci.RegisterDataType(DataType{Value: &Bool{}, Name: "bool", OID: BoolOID})
@@ -281,43 +431,73 @@ func NewConnInfo() *ConnInfo {
 	ci.RegisterDataType(DataType{Value: &CIDR{}, Name: "cidr", OID: CIDROID})
 	ci.RegisterDataType(DataType{Value: &Circle{}, Name: "circle", OID: CircleOID})
 	ci.RegisterDataType(DataType{Value: &Date{}, Name: "date", OID: DateOID})
+	ci.RegisterDataType(DataType{Value: &Datemultirange{}, Name: "datemultirange", OID: DatemultirangeOID})
 	ci.RegisterDataType(DataType{Value: &Daterange{}, Name: "daterange", OID: DaterangeOID})
 	ci.RegisterDataType(DataType{Value: &Float4{}, Name: "float4", OID: Float4OID})
 	ci.RegisterDataType(DataType{Value: &Float8{}, Name: "float8", OID: Float8OID})
 	ci.RegisterDataType(DataType{Value: &Inet{}, Name: "inet", OID: InetOID})
 	ci.RegisterDataType(DataType{Value: &Int2{}, Name: "int2", OID: Int2OID})
+	ci.RegisterDataType(DataType{Value: &Int2Vector{}, Name: "int2vector", OID: Int2VectorOID})
 	ci.RegisterDataType(DataType{Value: &Int4{}, Name: "int4", OID: Int4OID})
+	ci.RegisterDataType(DataType{Value: &Int4multirange{}, Name: "int4multirange", OID: Int4multirangeOID})
 	ci.RegisterDataType(DataType{Value: &Int4range{}, Name: "int4range", OID: Int4rangeOID})
 	ci.RegisterDataType(DataType{Value: &Int8{}, Name: "int8", OID: Int8OID})
+	ci.RegisterDataType(DataType{Value: &Int8multirange{}, Name: "int8multirange", OID: Int8multirangeOID})
 	ci.RegisterDataType(DataType{Value: &Int8range{}, Name: "int8range", OID: Int8rangeOID})
 	ci.RegisterDataType(DataType{Value: &Interval{}, Name: "interval", OID: IntervalOID})
 	ci.RegisterDataType(DataType{Value: &JSON{}, Name: "json", OID: JSONOID})
 	ci.RegisterDataType(DataType{Value: &JSONB{}, Name: "jsonb", OID: JSONBOID})
+	ci.RegisterDataType(DataType{Value: &JSONPath{}, Name: "jsonpath", OID: JSONPathOID})
 	ci.RegisterDataType(DataType{Value: &Line{}, Name: "line", OID: LineOID})
 	ci.RegisterDataType(DataType{Value: &Lseg{}, Name: "lseg", OID: LsegOID})
 	ci.RegisterDataType(DataType{Value: &Macaddr{}, Name: "macaddr", OID: MacaddrOID})
+	ci.RegisterDataType(DataType{Value: &Macaddr8{}, Name: "macaddr8", OID: Macaddr8OID})
+	ci.RegisterDataType(DataType{Value: &Money{}, Name: "money", OID: MoneyOID})
 	ci.RegisterDataType(DataType{Value: &Name{}, Name: "name", OID: NameOID})
+	ci.RegisterDataType(DataType{Value: &Nummultirange{}, Name: "nummultirange", OID: NummultirangeOID})
 	ci.RegisterDataType(DataType{Value: &Numeric{}, Name: "numeric", OID: NumericOID})
 	ci.RegisterDataType(DataType{Value: &Numrange{}, Name: "numrange", OID: NumrangeOID})
 	ci.RegisterDataType(DataType{Value: &OIDValue{}, Name: "oid", OID: OIDOID})
+	ci.RegisterDataType(DataType{Value: &OIDVector{}, Name: "oidvector", OID: OIDVectorOID})
 	ci.RegisterDataType(DataType{Value: &Path{}, Name: "path", OID: PathOID})
+	ci.RegisterDataType(DataType{Value: &LSN{}, Name: "pg_lsn", OID: PgLSNOID})
+	ci.RegisterDataType(DataType{Value: &Snapshot{}, Name: "pg_snapshot", OID: PgSnapshotOID})
 	ci.RegisterDataType(DataType{Value: &Point{}, Name: "point", OID: PointOID})
 	ci.RegisterDataType(DataType{Value: &Polygon{}, Name: "polygon", OID: PolygonOID})
 	ci.RegisterDataType(DataType{Value: &Record{}, Name: "record", OID: RecordOID})
+	ci.RegisterDataType(DataType{Value: &Regclass{}, Name: "regclass", OID: RegclassOID})
+	ci.RegisterDataType(DataType{Value: &Regcollation{}, Name: "regcollation", OID: RegcollationOID})
+	ci.RegisterDataType(DataType{Value: &Regconfig{}, Name: "regconfig", OID: RegconfigOID})
+	ci.RegisterDataType(DataType{Value: &Regdictionary{}, Name: "regdictionary", OID: RegdictionaryOID})
+	ci.RegisterDataType(DataType{Value: &Regnamespace{}, Name: "regnamespace", OID: RegnamespaceOID})
+	ci.RegisterDataType(DataType{Value: &Regoper{}, Name: "regoper", OID: RegoperOID})
+	ci.RegisterDataType(DataType{Value: &Regoperator{}, Name: "regoperator", OID: RegoperatorOID})
+	ci.RegisterDataType(DataType{Value: &Regproc{}, Name: "regproc", OID: RegprocOID})
+	ci.RegisterDataType(DataType{Value: &Regprocedure{}, Name: "regprocedure", OID: RegprocedureOID})
+	ci.RegisterDataType(DataType{Value: &Regrole{}, Name: "regrole", OID: RegroleOID})
+	ci.RegisterDataType(DataType{Value: &Regtype{}, Name: "regtype", OID: RegtypeOID})
 	ci.RegisterDataType(DataType{Value: &Text{}, Name: "text", OID: TextOID})
 	ci.RegisterDataType(DataType{Value: &TID{}, Name: "tid", OID: TIDOID})
 	ci.RegisterDataType(DataType{Value: &Time{}, Name: "time", OID: TimeOID})
 	ci.RegisterDataType(DataType{Value: &Timestamp{}, Name: "timestamp", OID: TimestampOID})
 	ci.RegisterDataType(DataType{Value: &Timestamptz{}, Name: "timestamptz", OID: TimestamptzOID})
+	ci.RegisterDataType(DataType{Value: &Timetz{}, Name: "timetz", OID: TimetzOID})
+	ci.RegisterDataType(DataType{Value: &Tsmultirange{}, Name: "tsmultirange", OID: TsmultirangeOID})
+	ci.RegisterDataType(DataType{Value: &TSQuery{}, Name: "tsquery", OID: TSQueryOID})
 	ci.RegisterDataType(DataType{Value: &Tsrange{}, Name: "tsrange", OID: TsrangeOID})
 	ci.RegisterDataType(DataType{Value: &TsrangeArray{}, Name: "_tsrange", OID: TsrangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &Tstzmultirange{}, Name: "tstzmultirange", OID: TstzmultirangeOID})
 	ci.RegisterDataType(DataType{Value: &Tstzrange{}, Name: "tstzrange", OID: TstzrangeOID})
 	ci.RegisterDataType(DataType{Value: &TstzrangeArray{}, Name: "_tstzrange", OID: TstzrangeArrayOID})
+	ci.RegisterDataType(DataType{Value: &TSVector{}, Name: "tsvector", OID: TSVectorOID})
+	ci.RegisterDataType(DataType{Value: &Snapshot{}, Name: "txid_snapshot", OID: TxidSnapshotOID})
 	ci.RegisterDataType(DataType{Value: &Unknown{}, Name: "unknown", OID: UnknownOID})
 	ci.RegisterDataType(DataType{Value: &UUID{}, Name: "uuid", OID: UUIDOID})
 	ci.RegisterDataType(DataType{Value: &Varbit{}, Name: "varbit", OID: VarbitOID})
 	ci.RegisterDataType(DataType{Value: &Varchar{}, Name: "varchar", OID: VarcharOID})
 	ci.RegisterDataType(DataType{Value: &XID{}, Name: "xid", OID: XIDOID})
+	ci.RegisterDataType(DataType{Value: &XID8{}, Name: "xid8", OID: XID8OID})
+	ci.RegisterDataType(DataType{Value: &XML{}, Name: "xml", OID: XMLOID})
 
 	registerDefaultPgTypeVariants := func(name, arrayName string, value interface{}) {
 		ci.RegisterDefaultPgType(value, name)
@@ -355,6 +535,10 @@ func NewConnInfo() *ConnInfo {
 	ci.RegisterDefaultPgType((*net.IPNet)(nil), "cidr")
 	ci.RegisterDefaultPgType([]*net.IPNet(nil), "_cidr")
 
+	// Snapshot and SnapshotArray are registered for both pg_snapshot and txid_snapshot
+	ci.RegisterDefaultPgType(&Snapshot{}, "pg_snapshot")
+	ci.RegisterDefaultPgType(&SnapshotArray{}, "_pg_snapshot")
+
 	return ci
 }
 
@@ -371,60 +555,132 @@ func (ci *ConnInfo) InitializeDataTypes(nameOIDs map[string]uint32) {
 }
 
 func (ci *ConnInfo) RegisterDataType(t DataType) {
-	t.Value = NewValue(t.Value)
+	t.setValue(NewValue(t.Value))
+
+	var paramFormatCode int16
+	if pfp, ok := t.Value.(ParamFormatPreferrer); ok {
+		paramFormatCode = pfp.PreferredParamFormat()
+	} else if _, ok := t.Value.(BinaryEncoder); ok {
+		paramFormatCode = BinaryFormatCode
+	}
+
+	var resultFormatCode int16
+	if rfp, ok := t.Value.(ResultFormatPreferrer); ok {
+		resultFormatCode = rfp.PreferredResultFormat()
+	} else if _, ok := t.Value.(BinaryDecoder); ok {
+		resultFormatCode = BinaryFormatCode
+	}
+
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
 
 	ci.oidToDataType[t.OID] = &t
 	ci.nameToDataType[t.Name] = &t
+	ci.oidToParamFormatCode[t.OID] = paramFormatCode
+	ci.oidToResultFormatCode[t.OID] = resultFormatCode
 
-	{
-		var formatCode int16
-		if pfp, ok := t.Value.(ParamFormatPreferrer); ok {
-			formatCode = pfp.PreferredParamFormat()
-		} else if _, ok := t.Value.(BinaryEncoder); ok {
-			formatCode = BinaryFormatCode
-		}
-		ci.oidToParamFormatCode[t.OID] = formatCode
+	ci.reflectTypeToDataType = nil // Invalidated by type registration
+}
+
+// setValue sets the Value of dt and the decoders derived from it.
+func (dt *DataType) setValue(v Value) {
+	dt.Value = v
+	dt.textDecoder, _ = v.(TextDecoder)
+	dt.binaryDecoder, _ = v.(BinaryDecoder)
+}
+
+// lookupResult returns dt as it should be returned by a lookup. For a shared ConnInfo it is a copy of dt with its own
+// Value. Otherwise it is dt itself. ci.mu must be held.
+func (ci *ConnInfo) lookupResult(dt *DataType) *DataType {
+	if !ci.shared {
+		return dt
 	}
 
-	{
-		var formatCode int16
-		if rfp, ok := t.Value.(ResultFormatPreferrer); ok {
-			formatCode = rfp.PreferredResultFormat()
-		} else if _, ok := t.Value.(BinaryDecoder); ok {
-			formatCode = BinaryFormatCode
-		}
-		ci.oidToResultFormatCode[t.OID] = formatCode
+	dt2 := &DataType{Name: dt.Name, OID: dt.OID}
+	dt2.setValue(NewValue(dt.Value))
+	return dt2
+}
+
+// RegisterArrayType registers an ArrayType with arrayOID for the data type already registered with elementOID. This
+// allows arrays of types such as CompositeType, EnumType, and RangeType to be used without manually constructing an
+// ArrayType. The array type is named the same way PostgreSQL names it: the element type name prefixed with an
+// underscore.
+func (ci *ConnInfo) RegisterArrayType(arrayOID, elementOID uint32) error {
+	dt, ok := ci.DataTypeForOID(elementOID)
+	if !ok {
+		return fmt.Errorf("no data type registered for oid: %d", elementOID)
 	}
 
-	if d, ok := t.Value.(TextDecoder); ok {
-		t.textDecoder = d
+	element, ok := dt.Value.(ValueTranscoder)
+	if !ok {
+		return fmt.Errorf("data type for oid does not implement ValueTranscoder: %d", elementOID)
 	}
 
-	if d, ok := t.Value.(BinaryDecoder); ok {
-		t.binaryDecoder = d
+	newElement := func() ValueTranscoder {
+		return NewValue(element).(ValueTranscoder)
 	}
 
-	ci.reflectTypeToDataType = nil // Invalidated by type registration
+	arrayName := arrayTypeName(dt.Name)
+	ci.RegisterDataType(DataType{Value: NewArrayType(arrayName, elementOID, newElement), Name: arrayName, OID: arrayOID})
+	return nil
+}
+
+// arrayTypeName returns the name of the array type of typeName. typeName may be schema qualified and quoted.
+func arrayTypeName(typeName string) string {
+	nameStart := 0
+	inQuotes := false
+	for i := 0; i < len(typeName); i++ {
+		switch typeName[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case '.':
+			if !inQuotes {
+				nameStart = i + 1
+			}
+		}
+	}
+
+	if nameStart < len(typeName) && typeName[nameStart] == '"' {
+		nameStart++
+	}
+
+	return typeName[:nameStart] + "_" + typeName[nameStart:]
 }
 
 // RegisterDefaultPgType registers a mapping of a Go type to a PostgreSQL type name. Typically the data type to be
 // encoded or decoded is determined by the PostgreSQL OID. But if the OID of a value to be encoded or decoded is
 // unknown, this additional mapping will be used by DataTypeForValue to determine a suitable data type.
 func (ci *ConnInfo) RegisterDefaultPgType(value interface{}, name string) {
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
+
 	ci.reflectTypeToName[reflect.TypeOf(value)] = name
 	ci.reflectTypeToDataType = nil // Invalidated by registering a default type
 }
 
 func (ci *ConnInfo) DataTypeForOID(oid uint32) (*DataType, bool) {
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+
 	dt, ok := ci.oidToDataType[oid]
-	return dt, ok
+	if !ok {
+		return nil, false
+	}
+	return ci.lookupResult(dt), true
 }
 
 func (ci *ConnInfo) DataTypeForName(name string) (*DataType, bool) {
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+
 	dt, ok := ci.nameToDataType[name]
-	return dt, ok
+	if !ok {
+		return nil, false
+	}
+	return ci.lookupResult(dt), true
 }
 
+// buildReflectTypeToDataType builds ci.reflectTypeToDataType. ci.mu must be held for writing.
 func (ci *ConnInfo) buildReflectTypeToDataType() {
 	ci.reflectTypeToDataType = make(map[reflect.Type]*DataType)
 
@@ -444,20 +700,40 @@ func (ci *ConnInfo) buildReflectTypeToDataType() {
 // DataTypeForValue finds a data type suitable for v. Use RegisterDataType to register types that can encode and decode
 // themselves. Use RegisterDefaultPgType to register that can be handled by a registered data type.
 func (ci *ConnInfo) DataTypeForValue(v interface{}) (*DataType, bool) {
+	ci.mu.RLock()
+	if ci.reflectTypeToDataType != nil {
+		defer ci.mu.RUnlock()
+		return ci.dataTypeForValue(v)
+	}
+	ci.mu.RUnlock()
+
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
 	if ci.reflectTypeToDataType == nil {
 		ci.buildReflectTypeToDataType()
 	}
+	return ci.dataTypeForValue(v)
+}
 
-	if tv, ok := v.(TypeValue); ok {
-		dt, ok := ci.nameToDataType[tv.TypeName()]
-		return dt, ok
+// dataTypeForValue implements DataTypeForValue. ci.mu must be held and ci.reflectTypeToDataType must be built.
+func (ci *ConnInfo) dataTypeForValue(v interface{}) (*DataType, bool) {
+	var dt *DataType
+	var ok bool
+	if tv, isTypeValue := v.(TypeValue); isTypeValue {
+		dt, ok = ci.nameToDataType[tv.TypeName()]
+	} else {
+		dt, ok = ci.reflectTypeToDataType[reflect.TypeOf(v)]
 	}
-
-	dt, ok := ci.reflectTypeToDataType[reflect.TypeOf(v)]
-	return dt, ok
+	if !ok {
+		return nil, false
+	}
+	return ci.lookupResult(dt), true
 }
 
 func (ci *ConnInfo) ParamFormatCodeForOID(oid uint32) int16 {
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+
 	fc, ok := ci.oidToParamFormatCode[oid]
 	if ok {
 		return fc
@@ -466,6 +742,9 @@ func (ci *ConnInfo) ParamFormatCodeForOID(oid uint32) int16 {
 }
 
 func (ci *ConnInfo) ResultFormatCodeForOID(oid uint32) int16 {
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+
 	fc, ok := ci.oidToResultFormatCode[oid]
 	if ok {
 		return fc
@@ -475,7 +754,11 @@ func (ci *ConnInfo) ResultFormatCodeForOID(oid uint32) int16 {
 
 // DeepCopy makes a deep copy of the ConnInfo.
 func (ci *ConnInfo) DeepCopy() *ConnInfo {
+	ci.mu.RLock()
+	defer ci.mu.RUnlock()
+
 	ci2 := newConnInfo()
+	ci2.shared = ci.shared
 
 	for _, dt := range ci.oidToDataType {
 		ci2.RegisterDataType(DataType{
@@ -492,6 +775,15 @@ func (ci *ConnInfo) DeepCopy() *ConnInfo {
 	return ci2
 }
 
+// Shared returns a copy of ci that can be shared by multiple goroutines and connections instead of making a DeepCopy
+// per connection. Every lookup on the returned ConnInfo returns a DataType with its own Value. This costs an allocation
+// per lookup, but a ScanPlan only does the lookup when it is planned.
+func (ci *ConnInfo) Shared() *ConnInfo {
+	ci2 := ci.DeepCopy()
+	ci2.shared = true
+	return ci2
+}
+
 // ScanPlan is a precompiled plan to scan into a type of destination.
 type ScanPlan interface {
 	// Scan scans src into dst. If the dst type has changed in an incompatible way a ScanPlan should automatically
@@ -576,7 +868,7 @@ func (plan *scanPlanDataTypeAssignTo) Scan(ci *ConnInfo, oid uint32, formatCode
 
 	// assignToErr might have failed because the type of destination has changed
 	newPlan := ci.PlanScan(oid, formatCode, dst)
-	if newPlan, sameType := newPlan.(*scanPlanDataTypeAssignTo); !sameType {
+	if _, sameType := newPlan.(*scanPlanDataTypeAssignTo); !sameType {
 		return newPlan.Scan(ci, oid, formatCode, src, dst)
 	}
 
@@ -785,6 +1077,46 @@ func (ci *ConnInfo) PlanScan(oid uint32, formatCode int16, dst interface{}) Scan
 			case ByteaOID, TextOID, VarcharOID, JSONOID:
 				return scanPlanBinaryBytes{}
 			}
+		// Common arrays are scanned directly into slices. The only allocation is the destination slice itself.
+		case *[]int16, *[]*int16:
+			if oid == Int2ArrayOID {
+				return scanPlanBinaryInt16Array{}
+			}
+		case *[]int32, *[]*int32:
+			if oid == Int4ArrayOID {
+				return scanPlanBinaryInt32Array{}
+			}
+		case *[]int64, *[]*int64:
+			if oid == Int8ArrayOID {
+				return scanPlanBinaryInt64Array{}
+			}
+		case *[]float32, *[]*float32:
+			if oid == Float4ArrayOID {
+				return scanPlanBinaryFloat32Array{}
+			}
+		case *[]float64, *[]*float64:
+			if oid == Float8ArrayOID {
+				return scanPlanBinaryFloat64Array{}
+			}
+		case *[]bool, *[]*bool:
+			if oid == BoolArrayOID {
+				return scanPlanBinaryBoolArray{}
+			}
+		case *[]string, *[]*string:
+			switch oid {
+			case TextArrayOID, VarcharArrayOID:
+				return scanPlanBinaryTextArray{}
+			case UUIDArrayOID:
+				return scanPlanBinaryUUIDArray{}
+			}
+		case *[][16]byte, *[]*[16]byte:
+			if oid == UUIDArrayOID {
+				return scanPlanBinaryUUIDArray{}
+			}
+		case *[]time.Time, *[]*time.Time:
+			if oid == TimestamptzArrayOID {
+				return scanPlanBinaryTimestamptzArray{}
+			}
 		case BinaryDecoder:
 			return scanPlanDstBinaryDecoder{}
 		}
@@ -836,6 +1168,272 @@ func (ci *ConnInfo) Scan(oid uint32, formatCode int16, src []byte, dst interface
 	return plan.Scan(ci, oid, formatCode, src, dst)
 }
 
+// EncodePlan is a precompiled plan to encode a type of value.
+type EncodePlan interface {
+	// Encode appends the encoded bytes of value to buf. If value is the SQL value NULL then it appends nothing and
+	// returns (nil, nil). If value is not of the type the plan was prepared for a new plan is prepared and used.
+	Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) (newBuf []byte, err error)
+}
+
+type encodePlanNull struct{}
+
+func (encodePlanNull) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBinaryEncoder struct{}
+
+func (encodePlanBinaryEncoder) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if encoder, ok := value.(BinaryEncoder); ok {
+		return encoder.EncodeBinary(ci, buf)
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanTextEncoder struct{}
+
+func (encodePlanTextEncoder) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if encoder, ok := value.(TextEncoder); ok {
+		return encoder.EncodeText(ci, buf)
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanDataTypeSet DataType
+
+func (plan *encodePlanDataTypeSet) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	dt := (*DataType)(plan)
+
+	setErr := dt.Value.Set(value)
+	if setErr != nil {
+		// setErr might have failed because the type of value has changed
+		newPlan := ci.PlanEncode(oid, formatCode, value)
+		if _, sameType := newPlan.(*encodePlanDataTypeSet); !sameType {
+			return newPlan.Encode(ci, oid, formatCode, value, buf)
+		}
+		return nil, setErr
+	}
+
+	switch formatCode {
+	case BinaryFormatCode:
+		if encoder, ok := dt.Value.(BinaryEncoder); ok {
+			return encoder.EncodeBinary(ci, buf)
+		}
+	case TextFormatCode:
+		if encoder, ok := dt.Value.(TextEncoder); ok {
+			return encoder.EncodeText(ci, buf)
+		}
+	}
+
+	return nil, fmt.Errorf("unable to encode %s in format code %d", dt.Name, formatCode)
+}
+
+type encodePlanUnknown struct{}
+
+func (encodePlanUnknown) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	if _, sameType := newPlan.(encodePlanUnknown); !sameType {
+		return newPlan.Encode(ci, oid, formatCode, value, buf)
+	}
+
+	return nil, fmt.Errorf("unable to encode %T into oid %d", value, oid)
+}
+
+type encodePlanBinaryInt16 struct{}
+
+func (encodePlanBinaryInt16) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(int16); ok {
+		return pgio.AppendInt16(buf, v), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBinaryInt32 struct{}
+
+func (encodePlanBinaryInt32) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(int32); ok {
+		return pgio.AppendInt32(buf, v), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBinaryInt64 struct{}
+
+func (encodePlanBinaryInt64) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(int64); ok {
+		return pgio.AppendInt64(buf, v), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBinaryFloat32 struct{}
+
+func (encodePlanBinaryFloat32) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(float32); ok {
+		return pgio.AppendUint32(buf, math.Float32bits(v)), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBinaryFloat64 struct{}
+
+func (encodePlanBinaryFloat64) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(float64); ok {
+		return pgio.AppendUint64(buf, math.Float64bits(v)), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBinaryTimestamptz struct{}
+
+func (encodePlanBinaryTimestamptz) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(time.Time); ok {
+		microsecSinceUnixEpoch := v.Unix()*1000000 + int64(v.Nanosecond())/1000
+		return pgio.AppendInt64(buf, microsecSinceUnixEpoch-microsecFromUnixEpochToY2K), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanBytes struct{}
+
+func (encodePlanBytes) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.([]byte); ok {
+		if v == nil {
+			return nil, nil
+		}
+		return append(buf, v...), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+type encodePlanString struct{}
+
+func (encodePlanString) Encode(ci *ConnInfo, oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	if v, ok := value.(string); ok {
+		return append(buf, v...), nil
+	}
+
+	newPlan := ci.PlanEncode(oid, formatCode, value)
+	return newPlan.Encode(ci, oid, formatCode, value, buf)
+}
+
+// PlanEncode prepares a plan to encode a value of the type of value into oid in formatCode. If oid is 0 the data type
+// is found with DataTypeForValue.
+func (ci *ConnInfo) PlanEncode(oid uint32, formatCode int16, value interface{}) EncodePlan {
+	if value == nil {
+		return encodePlanNull{}
+	}
+
+	switch formatCode {
+	case BinaryFormatCode:
+		switch value.(type) {
+		case string:
+			switch oid {
+			case TextOID, VarcharOID:
+				return encodePlanString{}
+			}
+		case int16:
+			if oid == Int2OID {
+				return encodePlanBinaryInt16{}
+			}
+		case int32:
+			if oid == Int4OID {
+				return encodePlanBinaryInt32{}
+			}
+		case int64:
+			if oid == Int8OID {
+				return encodePlanBinaryInt64{}
+			}
+		case float32:
+			if oid == Float4OID {
+				return encodePlanBinaryFloat32{}
+			}
+		case float64:
+			if oid == Float8OID {
+				return encodePlanBinaryFloat64{}
+			}
+		case time.Time:
+			if oid == TimestamptzOID {
+				return encodePlanBinaryTimestamptz{}
+			}
+		case []byte:
+			switch oid {
+			case ByteaOID, TextOID, VarcharOID:
+				return encodePlanBytes{}
+			}
+		case BinaryEncoder:
+			return encodePlanBinaryEncoder{}
+		}
+	case TextFormatCode:
+		switch value.(type) {
+		case string:
+			switch oid {
+			case TextOID, VarcharOID:
+				return encodePlanString{}
+			}
+		case []byte:
+			switch oid {
+			case TextOID, VarcharOID:
+				return encodePlanBytes{}
+			}
+		case TextEncoder:
+			return encodePlanTextEncoder{}
+		}
+	}
+
+	var dt *DataType
+
+	if oid == 0 {
+		if dataType, ok := ci.DataTypeForValue(value); ok {
+			dt = dataType
+		}
+	} else {
+		if dataType, ok := ci.DataTypeForOID(oid); ok {
+			dt = dataType
+		}
+	}
+
+	if dt != nil {
+		return (*encodePlanDataTypeSet)(dt)
+	}
+
+	if _, ok := value.(string); ok && formatCode == TextFormatCode {
+		return encodePlanString{}
+	}
+
+	return encodePlanUnknown{}
+}
+
+// Encode appends the encoded bytes of value to buf. If value is the SQL value NULL then it appends nothing and returns
+// (nil, nil).
+func (ci *ConnInfo) Encode(oid uint32, formatCode int16, value interface{}, buf []byte) ([]byte, error) {
+	plan := ci.PlanEncode(oid, formatCode, value)
+	return plan.Encode(ci, oid, formatCode, value, buf)
+}
+
 func scanUnknownType(oid uint32, formatCode int16, buf []byte, dest interface{}) error {
 	switch dest := dest.(type) {
 	case *string:
@@ -868,72 +1466,158 @@ var nameValues map[string]Value
 
 func init() {
 	nameValues = map[string]Value{
-		"_aclitem":     &ACLItemArray{},
-		"_bool":        &BoolArray{},
-		"_bpchar":      &BPCharArray{},
-		"_bytea":       &ByteaArray{},
-		"_cidr":        &CIDRArray{},
-		"_date":        &DateArray{},
-		"_float4":      &Float4Array{},
-		"_float8":      &Float8Array{},
-		"_inet":        &InetArray{},
-		"_int2":        &Int2Array{},
-		"_int4":        &Int4Array{},
-		"_int8":        &Int8Array{},
-		"_numeric":     &NumericArray{},
-		"_text":        &TextArray{},
-		"_timestamp":   &TimestampArray{},
-		"_timestamptz": &TimestamptzArray{},
-		"_uuid":        &UUIDArray{},
-		"_varchar":     &VarcharArray{},
-		"_jsonb":       &JSONBArray{},
-		"aclitem":      &ACLItem{},
-		"bit":          &Bit{},
-		"bool":         &Bool{},
-		"box":          &Box{},
-		"bpchar":       &BPChar{},
-		"bytea":        &Bytea{},
-		"char":         &QChar{},
-		"cid":          &CID{},
-		"cidr":         &CIDR{},
-		"circle":       &Circle{},
-		"date":         &Date{},
-		"daterange":    &Daterange{},
-		"float4":       &Float4{},
-		"float8":       &Float8{},
-		"hstore":       &Hstore{},
-		"inet":         &Inet{},
-		"int2":         &Int2{},
-		"int4":         &Int4{},
-		"int4range":    &Int4range{},
-		"int8":         &Int8{},
-		"int8range":    &Int8range{},
-		"interval":     &Interval{},
-		"json":         &JSON{},
-		"jsonb":        &JSONB{},
-		"line":         &Line{},
-		"lseg":         &Lseg{},
-		"macaddr":      &Macaddr{},
-		"name":         &Name{},
-		"numeric":      &Numeric{},
-		"numrange":     &Numrange{},
-		"oid":          &OIDValue{},
-		"path":         &Path{},
-		"point":        &Point{},
-		"polygon":      &Polygon{},
-		"record":       &Record{},
-		"text":         &Text{},
-		"tid":          &TID{},
-		"timestamp":    &Timestamp{},
-		"timestamptz":  &Timestamptz{},
-		"tsrange":      &Tsrange{},
-		"_tsrange":     &TsrangeArray{},
-		"tstzrange":    &Tstzrange{},
-		"_tstzrange":   &TstzrangeArray{},
-		"unknown":      &Unknown{},
-		"uuid":         &UUID{},
-		"varbit":       &Varbit{},
-		"varchar":      &Varchar{},
-		"xid":          &XID{},
+		"_aclitem":        &ACLItemArray{},
+		"_bit":            &BitArray{},
+		"_bool":           &BoolArray{},
+		"_box":            &BoxArray{},
+		"_bpchar":         &BPCharArray{},
+		"_bytea":          &ByteaArray{},
+		"_char":           &QCharArray{},
+		"_cid":            &CIDArray{},
+		"_cidr":           &CIDRArray{},
+		"_circle":         &CircleArray{},
+		"_date":           &DateArray{},
+		"_datemultirange": &DatemultirangeArray{},
+		"_daterange":      &DaterangeArray{},
+		"_float4":         &Float4Array{},
+		"_float8":         &Float8Array{},
+		"_hstore":         &HstoreArray{},
+		"_inet":           &InetArray{},
+		"_int2":           &Int2Array{},
+		"_int2vector":     &Int2VectorArray{},
+		"_int4":           &Int4Array{},
+		"_int4multirange": &Int4multirangeArray{},
+		"_int4range":      &Int4rangeArray{},
+		"_int8":           &Int8Array{},
+		"_int8multirange": &Int8multirangeArray{},
+		"_int8range":      &Int8rangeArray{},
+		"_interval":       &IntervalArray{},
+		"_json":           &JSONArray{},
+		"_jsonpath":       &JSONPathArray{},
+		"_line":           &LineArray{},
+		"_lseg":           &LsegArray{},
+		"_macaddr":        &MacaddrArray{},
+		"_macaddr8":       &Macaddr8Array{},
+		"_money":          &MoneyArray{},
+		"_name":           &NameArray{},
+		"_numeric":        &NumericArray{},
+		"_nummultirange":  &NummultirangeArray{},
+		"_numrange":       &NumrangeArray{},
+		"_oid":            &OIDValueArray{},
+		"_oidvector":      &OIDVectorArray{},
+		"_path":           &PathArray{},
+		"_pg_lsn":         &LSNArray{},
+		"_pg_snapshot":    &SnapshotArray{},
+		"_point":          &PointArray{},
+		"_polygon":        &PolygonArray{},
+		"_record":         &RecordArray{},
+		"_regclass":       &RegclassArray{},
+		"_regcollation":   &RegcollationArray{},
+		"_regconfig":      &RegconfigArray{},
+		"_regdictionary":  &RegdictionaryArray{},
+		"_regnamespace":   &RegnamespaceArray{},
+		"_regoper":        &RegoperArray{},
+		"_regoperator":    &RegoperatorArray{},
+		"_regproc":        &RegprocArray{},
+		"_regprocedure":   &RegprocedureArray{},
+		"_regrole":        &RegroleArray{},
+		"_regtype":        &RegtypeArray{},
+		"_text":           &TextArray{},
+		"_tid":            &TIDArray{},
+		"_time":           &TimeArray{},
+		"_timestamp":      &TimestampArray{},
+		"_timestamptz":    &TimestamptzArray{},
+		"_timetz":         &TimetzArray{},
+		"_tsmultirange":   &TsmultirangeArray{},
+		"_tsquery":        &TSQueryArray{},
+		"_tstzmultirange": &TstzmultirangeArray{},
+		"_tsvector":       &TSVectorArray{},
+		"_txid_snapshot":  &SnapshotArray{},
+		"_uuid":           &UUIDArray{},
+		"_varbit":         &VarbitArray{},
+		"_varchar":        &VarcharArray{},
+		"_xid":            &XIDArray{},
+		"_xid8":           &XID8Array{},
+		"_xml":            &XMLArray{},
+		"_jsonb":          &JSONBArray{},
+		"aclitem":         &ACLItem{},
+		"bit":             &Bit{},
+		"bool":            &Bool{},
+		"box":             &Box{},
+		"bpchar":          &BPChar{},
+		"bytea":           &Bytea{},
+		"char":            &QChar{},
+		"cid":             &CID{},
+		"cidr":            &CIDR{},
+		"circle":          &Circle{},
+		"date":            &Date{},
+		"datemultirange":  &Datemultirange{},
+		"daterange":       &Daterange{},
+		"float4":          &Float4{},
+		"float8":          &Float8{},
+		"hstore":          &Hstore{},
+		"inet":            &Inet{},
+		"int2":            &Int2{},
+		"int2vector":      &Int2Vector{},
+		"int4":            &Int4{},
+		"int4multirange":  &Int4multirange{},
+		"int4range":       &Int4range{},
+		"int8":            &Int8{},
+		"int8multirange":  &Int8multirange{},
+		"int8range":       &Int8range{},
+		"interval":        &Interval{},
+		"json":            &JSON{},
+		"jsonb":           &JSONB{},
+		"jsonpath":        &JSONPath{},
+		"line":            &Line{},
+		"lseg":            &Lseg{},
+		"macaddr":         &Macaddr{},
+		"macaddr8":        &Macaddr8{},
+		"money":           &Money{},
+		"name":            &Name{},
+		"numeric":         &Numeric{},
+		"nummultirange":   &Nummultirange{},
+		"numrange":        &Numrange{},
+		"oid":             &OIDValue{},
+		"oidvector":       &OIDVector{},
+		"path":            &Path{},
+		"pg_lsn":          &LSN{},
+		"pg_snapshot":     &Snapshot{},
+		"point":           &Point{},
+		"polygon":         &Polygon{},
+		"record":          &Record{},
+		"regclass":        &Regclass{},
+		"regcollation":    &Regcollation{},
+		"regconfig":       &Regconfig{},
+		"regdictionary":   &Regdictionary{},
+		"regnamespace":    &Regnamespace{},
+		"regoper":         &Regoper{},
+		"regoperator":     &Regoperator{},
+		"regproc":         &Regproc{},
+		"regprocedure":    &Regprocedure{},
+		"regrole":         &Regrole{},
+		"regtype":         &Regtype{},
+		"text":            &Text{},
+		"tid":             &TID{},
+		"time":            &Time{},
+		"timestamp":       &Timestamp{},
+		"timestamptz":     &Timestamptz{},
+		"timetz":          &Timetz{},
+		"tsmultirange":    &Tsmultirange{},
+		"tsquery":         &TSQuery{},
+		"tsrange":         &Tsrange{},
+		"_tsrange":        &TsrangeArray{},
+		"tstzmultirange":  &Tstzmultirange{},
+		"tstzrange":       &Tstzrange{},
+		"_tstzrange":      &TstzrangeArray{},
+		"tsvector":        &TSVector{},
+		"txid_snapshot":   &Snapshot{},
+		"unknown":         &Unknown{},
+		"uuid":            &UUID{},
+		"varbit":          &Varbit{},
+		"varchar":         &Varchar{},
+		"xid":             &XID{},
+		"xid8":            &XID8{},
+		"xml":             &XML{},
 	}
 }
@@ -3,8 +3,11 @@ package pgtype_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"net"
+	"sync"
 	"testing"
+	"time"
 
 	_ "github.com/lib/pq"
 	"github.com/matthewpi/pgtype"
@@ -276,3 +279,253 @@ func BenchmarkScanPlanScanInt4IntoGoInt32(b *testing.B) {
 		}
 	}
 }
+
+func TestConnInfoPlanEncodeFastPathsMatchValues(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 8000, time.UTC)
+
+	tests := []struct {
+		oid        uint32
+		formatCode int16
+		value      interface{}
+		expected   pgtype.Value
+	}{
+		{pgtype.Int2OID, pgtype.BinaryFormatCode, int16(-42), &pgtype.Int2{Int: -42, Status: pgtype.Present}},
+		{pgtype.Int4OID, pgtype.BinaryFormatCode, int32(42), &pgtype.Int4{Int: 42, Status: pgtype.Present}},
+		{pgtype.Int8OID, pgtype.BinaryFormatCode, int64(1 << 40), &pgtype.Int8{Int: 1 << 40, Status: pgtype.Present}},
+		{pgtype.Float4OID, pgtype.BinaryFormatCode, float32(1.5), &pgtype.Float4{Float: 1.5, Status: pgtype.Present}},
+		{pgtype.Float8OID, pgtype.BinaryFormatCode, float64(-2.25), &pgtype.Float8{Float: -2.25, Status: pgtype.Present}},
+		{pgtype.TimestamptzOID, pgtype.BinaryFormatCode, ts, &pgtype.Timestamptz{Time: ts, Status: pgtype.Present}},
+		{pgtype.ByteaOID, pgtype.BinaryFormatCode, []byte{1, 2, 3}, &pgtype.Bytea{Bytes: []byte{1, 2, 3}, Status: pgtype.Present}},
+		{pgtype.TextOID, pgtype.BinaryFormatCode, "foo", &pgtype.Text{String: "foo", Status: pgtype.Present}},
+		{pgtype.TextOID, pgtype.TextFormatCode, "foo", &pgtype.Text{String: "foo", Status: pgtype.Present}},
+		{pgtype.VarcharOID, pgtype.TextFormatCode, []byte("foo"), &pgtype.Varchar{String: "foo", Status: pgtype.Present}},
+	}
+
+	for i, tt := range tests {
+		var expected []byte
+		var err error
+		if tt.formatCode == pgtype.BinaryFormatCode {
+			expected, err = tt.expected.(pgtype.BinaryEncoder).EncodeBinary(ci, nil)
+		} else {
+			expected, err = tt.expected.(pgtype.TextEncoder).EncodeText(ci, nil)
+		}
+		require.NoErrorf(t, err, "%d", i)
+
+		plan := ci.PlanEncode(tt.oid, tt.formatCode, tt.value)
+		buf, err := plan.Encode(ci, tt.oid, tt.formatCode, tt.value, []byte{0xff})
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, append([]byte{0xff}, expected...), buf, "%d", i)
+	}
+}
+
+func TestConnInfoPlanEncodeFallsBackToValue(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	n := int64(42)
+	buf, err := ci.Encode(pgtype.Int4OID, pgtype.BinaryFormatCode, &n, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 42}, buf)
+
+	buf, err = ci.Encode(pgtype.Int8OID, pgtype.TextFormatCode, int64(42), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("42"), buf)
+
+	buf, err = ci.Encode(0, pgtype.TextFormatCode, pgtype.Int4{Int: 7, Status: pgtype.Present}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("7"), buf)
+
+	buf, err = ci.Encode(0, pgtype.BinaryFormatCode, _string("foo"), nil)
+	require.Error(t, err)
+	require.Nil(t, buf)
+
+	buf, err = ci.Encode(999999, pgtype.TextFormatCode, "foo", nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), buf)
+
+	_, err = ci.Encode(999999, pgtype.BinaryFormatCode, 1, nil)
+	require.Error(t, err)
+}
+
+func TestConnInfoPlanEncodeNull(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for _, value := range []interface{}{nil, (*int64)(nil), pgtype.Int8{Status: pgtype.Null}} {
+		buf, err := ci.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, value, []byte{0xff})
+		require.NoError(t, err)
+		require.Nilf(t, buf, "%#v", value)
+	}
+
+	buf, err := ci.Encode(pgtype.ByteaOID, pgtype.BinaryFormatCode, []byte(nil), []byte{0xff})
+	require.NoError(t, err)
+	require.Nil(t, buf)
+}
+
+func TestEncodePlanBinaryInt64EncodeChangedType(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	plan := ci.PlanEncode(pgtype.Int8OID, pgtype.BinaryFormatCode, int64(42))
+	buf, err := plan.Encode(ci, pgtype.Int8OID, pgtype.BinaryFormatCode, int64(42), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 42}, buf)
+
+	buf, err = plan.Encode(ci, pgtype.Int8OID, pgtype.BinaryFormatCode, int32(43), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 43}, buf)
+
+	buf, err = plan.Encode(ci, pgtype.Int8OID, pgtype.BinaryFormatCode, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, buf)
+}
+
+func TestEncodePlanDataTypeSetEncodeChangedType(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	plan := ci.PlanEncode(pgtype.Int8OID, pgtype.BinaryFormatCode, "42")
+	buf, err := plan.Encode(ci, pgtype.Int8OID, pgtype.BinaryFormatCode, "42", nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 42}, buf)
+
+	// Int8.Set fails for *Box so the plan must fall back to a new plan for the BinaryEncoder.
+	box := &pgtype.Box{
+		P:      [2]pgtype.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}},
+		Status: pgtype.Present,
+	}
+	expected, err := box.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	buf, err = plan.Encode(ci, pgtype.Int8OID, pgtype.BinaryFormatCode, box, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, buf)
+}
+
+func BenchmarkConnInfoEncodeGoInt64IntoInt8(b *testing.B) {
+	ci := pgtype.NewConnInfo()
+	buf := make([]byte, 0, 8)
+
+	for i := 0; i < b.N; i++ {
+		var err error
+		buf, err = ci.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, int64(42), buf[:0])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodePlanEncodeGoInt64IntoInt8(b *testing.B) {
+	ci := pgtype.NewConnInfo()
+	buf := make([]byte, 0, 8)
+
+	plan := ci.PlanEncode(pgtype.Int8OID, pgtype.BinaryFormatCode, int64(42))
+
+	for i := 0; i < b.N; i++ {
+		var err error
+		buf, err = plan.Encode(ci, pgtype.Int8OID, pgtype.BinaryFormatCode, int64(42), buf[:0])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestConnInfoMacaddrArrayTypesRegistered(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for _, name := range []string{"_macaddr", "_macaddr8", "macaddr8"} {
+		_, ok := ci.DataTypeForName(name)
+		require.Truef(t, ok, "%s is not registered", name)
+	}
+
+	dt, ok := ci.DataTypeForOID(pgtype.MacaddrArrayOID)
+	require.True(t, ok)
+	require.IsType(t, &pgtype.MacaddrArray{}, dt.Value)
+}
+
+func TestConnInfoConcurrentRegistrationAndLookup(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				oid := uint32(200000 + i*100 + j)
+				name := fmt.Sprintf("mood_%d_%d", i, j)
+				ci.RegisterDataType(pgtype.DataType{Value: pgtype.NewEnumType(name, []string{"happy", "sad"}), Name: name, OID: oid})
+				ci.RegisterDefaultPgType(_string(""), "text")
+
+				_, ok := ci.DataTypeForOID(oid)
+				assert.True(t, ok)
+				_, ok = ci.DataTypeForName(name)
+				assert.True(t, ok)
+				_, ok = ci.DataTypeForValue(&pgtype.Int4{})
+				assert.True(t, ok)
+				assert.EqualValues(t, pgtype.TextFormatCode, ci.ParamFormatCodeForOID(oid))
+				assert.EqualValues(t, pgtype.BinaryFormatCode, ci.ResultFormatCodeForOID(pgtype.Int4OID))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestConnInfoSharedConcurrentScan(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+	ci.RegisterDataType(pgtype.DataType{Value: pgtype.NewEnumType("mood", []string{"happy", "sad"}), Name: "mood", OID: 200000})
+	ci = ci.Shared()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			var n int64
+			intPlan := ci.PlanScan(pgtype.Int8OID, pgtype.TextFormatCode, &n)
+
+			var mood string
+			moodPlan := ci.PlanScan(200000, pgtype.BinaryFormatCode, &mood)
+
+			for j := 0; j < 100; j++ {
+				expected := int64(i*100 + j)
+				err := intPlan.Scan(ci, pgtype.Int8OID, pgtype.TextFormatCode, []byte(fmt.Sprint(expected)), &n)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, n)
+
+				expectedMood := []string{"happy", "sad"}[j%2]
+				err = moodPlan.Scan(ci, 200000, pgtype.BinaryFormatCode, []byte(expectedMood), &mood)
+				assert.NoError(t, err)
+				assert.Equal(t, expectedMood, mood)
+
+				var s pgtype.Text
+				err = ci.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte(expectedMood), &s)
+				assert.NoError(t, err)
+				assert.Equal(t, expectedMood, s.String)
+
+				if j%10 == 0 {
+					name := fmt.Sprintf("mood_%d_%d", i, j)
+					ci.RegisterDataType(pgtype.DataType{Value: pgtype.NewEnumType(name, []string{"happy"}), Name: name, OID: uint32(300000 + i*100 + j)})
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestConnInfoSharedLookupReturnsOwnValue(t *testing.T) {
+	ci := pgtype.NewConnInfo().Shared()
+
+	dt1, ok := ci.DataTypeForOID(pgtype.Int4OID)
+	require.True(t, ok)
+	dt2, ok := ci.DataTypeForOID(pgtype.Int4OID)
+	require.True(t, ok)
+
+	require.NoError(t, dt1.Value.Set(1))
+	require.NoError(t, dt2.Value.Set(2))
+	require.EqualValues(t, 1, dt1.Value.Get())
+	require.EqualValues(t, 2, dt2.Value.Get())
+
+	ci2 := ci.DeepCopy()
+	dt3, ok := ci2.DataTypeForOID(pgtype.Int4OID)
+	require.True(t, ok)
+	require.NotSame(t, dt3.Value, dt1.Value)
+}
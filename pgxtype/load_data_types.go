@@ -0,0 +1,295 @@
+package pgxtype
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matthewpi/pgtype"
+)
+
+// LoadDataTypes uses conn to inspect the database for typeNames and registers the data types for typeNames, their
+// array types, and all of their dependencies on ci. Unlike LoadDataType, the catalog is read with a small fixed number
+// of queries regardless of how many types are loaded. This makes it suitable for registering many types when a
+// connection is established.
+//
+// Types that are already registered on ci are not loaded again. The data types that were registered are returned in
+// the order they were registered. A type is always registered after its dependencies.
+func LoadDataTypes(ctx context.Context, conn Querier, ci *pgtype.ConnInfo, typeNames []string) ([]pgtype.DataType, error) {
+	if len(typeNames) == 0 {
+		return nil, nil
+	}
+
+	rows, err := conn.Query(ctx, `select n.name, t.oid, t.typarray
+from unnest($1::text[]) with ordinality as n(name, ord)
+	join pg_type t on t.oid=n.name::regtype::oid
+order by n.ord`, typeNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rootOIDs []uint32
+	names := make(map[uint32]string, len(typeNames))
+	for rows.Next() {
+		var name string
+		var oid, arrayOID uint32
+		err := rows.Scan(&name, &oid, &arrayOID)
+		if err != nil {
+			return nil, err
+		}
+		rootOIDs = append(rootOIDs, oid)
+		names[oid] = name
+		if arrayOID != 0 {
+			rootOIDs = append(rootOIDs, arrayOID)
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return loadDataTypes(ctx, conn, ci, rootOIDs, names)
+}
+
+// LoadSchemaDataTypes uses conn to inspect the database for all user defined composite, enum, range, and domain types
+// in schemas and registers them, their array types, and all of their dependencies on ci. It reads the catalog in the
+// same way as LoadDataTypes.
+func LoadSchemaDataTypes(ctx context.Context, conn Querier, ci *pgtype.ConnInfo, schemas []string) ([]pgtype.DataType, error) {
+	if len(schemas) == 0 {
+		return nil, nil
+	}
+
+	rows, err := conn.Query(ctx, `select t.oid, t.typarray
+from pg_type t
+	join pg_namespace n on n.oid=t.typnamespace
+	left join pg_class c on c.oid=t.typrelid
+where n.nspname=any($1)
+	and (t.typtype in ('e', 'r', 'd') or (t.typtype='c' and c.relkind='c'))
+order by t.oid`, schemas)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rootOIDs []uint32
+	for rows.Next() {
+		var oid, arrayOID uint32
+		err := rows.Scan(&oid, &arrayOID)
+		if err != nil {
+			return nil, err
+		}
+		rootOIDs = append(rootOIDs, oid)
+		if arrayOID != 0 {
+			rootOIDs = append(rootOIDs, arrayOID)
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return loadDataTypes(ctx, conn, ci, rootOIDs, nil)
+}
+
+// loadDataTypes loads rootOIDs and their dependencies and registers them on ci. names overrides the catalog name of
+// any of the types.
+func loadDataTypes(ctx context.Context, conn Querier, ci *pgtype.ConnInfo, rootOIDs []uint32, names map[uint32]string) ([]pgtype.DataType, error) {
+	if len(rootOIDs) == 0 {
+		return nil, nil
+	}
+
+	infos, err := getTypeInfos(ctx, conn, ci, rootOIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for oid, name := range names {
+		if t, ok := infos[oid]; ok {
+			t.name = name
+		}
+	}
+
+	s := &typeSorter{ci: ci, infos: infos, state: make(map[uint32]bool, len(infos))}
+	for _, oid := range rootOIDs {
+		if err := s.register(oid); err != nil {
+			return s.registered, err
+		}
+	}
+
+	return s.registered, nil
+}
+
+// getTypeInfos reads the information needed to build the data types for rootOIDs and all of their dependencies from the
+// catalog. Types that are already registered on ci are not included in the result.
+func getTypeInfos(ctx context.Context, conn Querier, ci *pgtype.ConnInfo, rootOIDs []uint32) (map[uint32]*typeInfo, error) {
+	rows, err := conn.Query(ctx, `with recursive deps(oid) as (
+	select unnest($1::oid[])
+	union
+	select d.oid
+	from deps
+		join pg_type t on t.oid=deps.oid
+		cross join lateral (
+			select t.typelem where t.typtype='b' and t.typelem<>0
+			union all
+			select t.typbasetype where t.typtype='d'
+			union all
+			select rngsubtype from pg_range where rngtypid=t.oid
+			union all
+			select atttypid from pg_attribute where t.typtype='c' and attrelid=t.typrelid and attnum>0 and not attisdropped
+		) d(oid)
+)
+select t.oid,
+	case when pg_type_is_visible(t.oid) then quote_ident(t.typname)
+		else quote_ident(n.nspname) || '.' || quote_ident(t.typname) end,
+	t.typtype::text,
+	case when t.typtype='b' then t.typelem else coalesce(r.rngsubtype, 0) end,
+	t.typbasetype
+from deps
+	join pg_type t on t.oid=deps.oid
+	join pg_namespace n on n.oid=t.typnamespace
+	left join pg_range r on r.rngtypid=t.oid`, rootOIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	infos := make(map[uint32]*typeInfo)
+	var compositeOIDs, enumOIDs []uint32
+	for rows.Next() {
+		t := &typeInfo{}
+		err := rows.Scan(&t.oid, &t.name, &t.typtype, &t.elementOID, &t.baseOID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := ci.DataTypeForOID(t.oid); ok {
+			continue
+		}
+
+		infos[t.oid] = t
+		switch t.typtype {
+		case "c":
+			compositeOIDs = append(compositeOIDs, t.oid)
+		case "e":
+			enumOIDs = append(enumOIDs, t.oid)
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	if len(compositeOIDs) > 0 {
+		err := getAllCompositeFields(ctx, conn, compositeOIDs, infos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(enumOIDs) > 0 {
+		err := getAllEnumMembers(ctx, conn, enumOIDs, infos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return infos, nil
+}
+
+func getAllCompositeFields(ctx context.Context, conn Querier, oids []uint32, infos map[uint32]*typeInfo) error {
+	rows, err := conn.Query(ctx, `select t.oid, a.attname, a.atttypid
+from pg_type t
+	join pg_attribute a on a.attrelid=t.typrelid
+where t.oid=any($1)
+	and a.attnum>0
+	and not a.attisdropped
+order by t.oid, a.attnum`, oids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var oid uint32
+		var f pgtype.CompositeTypeField
+		err := rows.Scan(&oid, &f.Name, &f.OID)
+		if err != nil {
+			return err
+		}
+		t := infos[oid]
+		t.fields = append(t.fields, f)
+	}
+
+	return rows.Err()
+}
+
+func getAllEnumMembers(ctx context.Context, conn Querier, oids []uint32, infos map[uint32]*typeInfo) error {
+	for _, oid := range oids {
+		infos[oid].members = []string{}
+	}
+
+	rows, err := conn.Query(ctx, `select enumtypid, enumlabel
+from pg_enum
+where enumtypid=any($1)
+order by enumtypid, enumsortorder`, oids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var oid uint32
+		var m string
+		err := rows.Scan(&oid, &m)
+		if err != nil {
+			return err
+		}
+		t := infos[oid]
+		t.members = append(t.members, m)
+	}
+
+	return rows.Err()
+}
+
+// typeSorter registers data types on ci in dependency order.
+type typeSorter struct {
+	ci    *pgtype.ConnInfo
+	infos map[uint32]*typeInfo
+
+	// state is false while a type is being registered and true once it has been registered.
+	state map[uint32]bool
+
+	registered []pgtype.DataType
+}
+
+func (s *typeSorter) register(oid uint32) error {
+	t, ok := s.infos[oid]
+	if !ok {
+		// Already registered on ci before loading began.
+		return nil
+	}
+
+	if done, ok := s.state[oid]; ok {
+		if done {
+			return nil
+		}
+		return fmt.Errorf("cyclic type dependency on oid: %d", oid)
+	}
+
+	s.state[oid] = false
+	for _, depOID := range t.dependencies() {
+		if err := s.register(depOID); err != nil {
+			return err
+		}
+	}
+
+	dt, err := newDataType(s.ci, t)
+	if err != nil {
+		return err
+	}
+
+	s.ci.RegisterDataType(dt)
+	s.registered = append(s.registered, dt)
+	s.state[oid] = true
+	return nil
+}
@@ -0,0 +1,113 @@
+package pgxtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewpi/pgtype/pgxtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDataTypes(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `drop type if exists pgxtype_batch_order;
+drop type if exists pgxtype_batch_line_item;
+drop type if exists pgxtype_batch_status;
+
+create type pgxtype_batch_status as enum ('pending', 'shipped');
+
+create type pgxtype_batch_line_item as (
+	sku text,
+	quantity int4,
+	status pgxtype_batch_status
+);
+
+create type pgxtype_batch_order as (
+	id int8,
+	status pgxtype_batch_status,
+	items pgxtype_batch_line_item[]
+);`)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), `drop type if exists pgxtype_batch_order;
+drop type if exists pgxtype_batch_line_item;
+drop type if exists pgxtype_batch_status;`)
+
+	ci := conn.ConnInfo()
+	dts, err := pgxtype.LoadDataTypes(context.Background(), conn, ci, []string{"pgxtype_batch_order", "pgxtype_batch_status"})
+	require.NoError(t, err)
+
+	registered := make(map[string]int, len(dts))
+	for i, dt := range dts {
+		registered[dt.Name] = i
+	}
+	for _, name := range []string{"pgxtype_batch_status", "_pgxtype_batch_status", "pgxtype_batch_line_item", "_pgxtype_batch_line_item", "pgxtype_batch_order", "_pgxtype_batch_order"} {
+		require.Containsf(t, registered, name, "%s not registered", name)
+		_, ok := ci.DataTypeForName(name)
+		require.Truef(t, ok, "%s not registered", name)
+	}
+	require.Less(t, registered["pgxtype_batch_status"], registered["pgxtype_batch_line_item"])
+	require.Less(t, registered["pgxtype_batch_line_item"], registered["_pgxtype_batch_line_item"])
+	require.Less(t, registered["_pgxtype_batch_line_item"], registered["pgxtype_batch_order"])
+
+	type lineItem struct {
+		SKU      string
+		Quantity int32
+		Status   string
+	}
+
+	type order struct {
+		ID     int64
+		Status string
+		Items  []lineItem
+	}
+
+	var result []order
+	err = conn.QueryRow(context.Background(), `select array[row(1, 'shipped', array[row('abc', 2, 'pending')::pgxtype_batch_line_item])::pgxtype_batch_order]`).Scan(&result)
+	require.NoError(t, err)
+	require.Equal(t, []order{{ID: 1, Status: "shipped", Items: []lineItem{{SKU: "abc", Quantity: 2, Status: "pending"}}}}, result)
+
+	dts, err = pgxtype.LoadDataTypes(context.Background(), conn, ci, []string{"pgxtype_batch_order"})
+	require.NoError(t, err)
+	require.Empty(t, dts)
+}
+
+func TestLoadSchemaDataTypes(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `drop schema if exists pgxtype_batch cascade;
+
+create schema pgxtype_batch;
+
+create type pgxtype_batch.mood as enum ('happy', 'sad');
+
+create type pgxtype_batch.person as (
+	name text,
+	mood pgxtype_batch.mood
+);
+
+create domain pgxtype_batch.positive_amount as int8 check (value > 0);
+
+create table pgxtype_batch.ignored (id int8);`)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), "drop schema if exists pgxtype_batch cascade")
+
+	ci := conn.ConnInfo()
+	_, err = pgxtype.LoadSchemaDataTypes(context.Background(), conn, ci, []string{"pgxtype_batch"})
+	require.NoError(t, err)
+
+	for _, name := range []string{"pgxtype_batch.mood", "pgxtype_batch._mood", "pgxtype_batch.person", "pgxtype_batch._person", "pgxtype_batch.positive_amount"} {
+		_, ok := ci.DataTypeForName(name)
+		require.Truef(t, ok, "%s not registered", name)
+	}
+	_, ok := ci.DataTypeForName("pgxtype_batch.ignored")
+	require.False(t, ok)
+
+	var moods []string
+	err = conn.QueryRow(context.Background(), "select array['happy', 'sad']::pgxtype_batch.mood[]").Scan(&moods)
+	require.NoError(t, err)
+	require.Equal(t, []string{"happy", "sad"}, moods)
+}
@@ -3,6 +3,7 @@ package pgxtype
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/matthewpi/pgconn"
 	"github.com/matthewpi/pgtype"
@@ -17,6 +18,11 @@ type Querier interface {
 
 // LoadDataType uses conn to inspect the database for typeName and produces a pgtype.DataType suitable for
 // registration on ci.
+//
+// Any types typeName depends on that are not already registered on ci are loaded and registered on ci first. e.g. The
+// types of the fields of a composite type, the element type of an array, the subtype of a range, or the base type of a
+// domain. Dependencies are loaded recursively so the whole type graph of typeName is registered in dependency order.
+// Each dependency is registered once. The data type for typeName itself is not registered.
 func LoadDataType(ctx context.Context, conn Querier, ci *pgtype.ConnInfo, typeName string) (pgtype.DataType, error) {
 	var oid uint32
 
@@ -25,54 +31,200 @@ func LoadDataType(ctx context.Context, conn Querier, ci *pgtype.ConnInfo, typeNa
 		return pgtype.DataType{}, err
 	}
 
-	var typtype string
+	l := &loader{conn: conn, ci: ci, loading: make(map[uint32]struct{})}
+	return l.loadDataType(ctx, typeName, oid)
+}
+
+// loader loads data types and their dependencies.
+type loader struct {
+	conn Querier
+	ci   *pgtype.ConnInfo
+
+	// loading is the set of OIDs that are currently being loaded. It is used to detect cyclic dependencies.
+	loading map[uint32]struct{}
+}
+
+// ensureRegistered loads and registers the data type for oid on l.ci unless it is already registered.
+func (l *loader) ensureRegistered(ctx context.Context, oid uint32) error {
+	if _, ok := l.ci.DataTypeForOID(oid); ok {
+		return nil
+	}
+
+	if _, ok := l.loading[oid]; ok {
+		return fmt.Errorf("cyclic type dependency on oid: %d", oid)
+	}
+
+	typeName, err := GetTypeName(ctx, l.conn, oid)
+	if err != nil {
+		return err
+	}
 
-	err = conn.QueryRow(ctx, "select typtype::text from pg_type where oid=$1", oid).Scan(&typtype)
+	dt, err := l.loadDataType(ctx, typeName, oid)
+	if err != nil {
+		return err
+	}
+
+	l.ci.RegisterDataType(dt)
+	return nil
+}
+
+func (l *loader) loadDataType(ctx context.Context, typeName string, oid uint32) (pgtype.DataType, error) {
+	l.loading[oid] = struct{}{}
+	defer delete(l.loading, oid)
+
+	t := &typeInfo{oid: oid, name: typeName}
+
+	err := l.conn.QueryRow(ctx, "select typtype::text from pg_type where oid=$1", oid).Scan(&t.typtype)
 	if err != nil {
 		return pgtype.DataType{}, err
 	}
 
-	switch typtype {
-	case "b": // array
-		elementOID, err := GetArrayElementOID(ctx, conn, oid)
-		if err != nil {
+	switch t.typtype {
+	case "b":
+		t.elementOID, err = GetArrayElementOID(ctx, l.conn, oid)
+	case "c":
+		t.fields, err = GetCompositeFields(ctx, l.conn, oid)
+	case "e":
+		t.members, err = GetEnumMembers(ctx, l.conn, oid)
+	case "r":
+		t.elementOID, err = GetRangeElementOID(ctx, l.conn, oid)
+	case "d":
+		t.baseOID, err = GetDomainBaseOID(ctx, l.conn, oid)
+	}
+	if err != nil {
+		return pgtype.DataType{}, err
+	}
+
+	for _, depOID := range t.dependencies() {
+		if err := l.ensureRegistered(ctx, depOID); err != nil {
 			return pgtype.DataType{}, err
 		}
+	}
+
+	return newDataType(l.ci, t)
+}
 
-		var element pgtype.ValueTranscoder
-		if dt, ok := ci.DataTypeForOID(elementOID); ok {
-			if element, ok = dt.Value.(pgtype.ValueTranscoder); !ok {
-				return pgtype.DataType{}, errors.New("array element OID not registered as ValueTranscoder")
-			}
+// typeInfo is the information from the system catalogs needed to build a pgtype.DataType.
+type typeInfo struct {
+	oid     uint32
+	name    string
+	typtype string
+
+	elementOID uint32 // array element type or range subtype
+	baseOID    uint32 // domain base type
+	fields     []pgtype.CompositeTypeField
+	members    []string
+}
+
+// dependencies returns the OIDs of the types that must be registered before t.
+func (t *typeInfo) dependencies() []uint32 {
+	switch t.typtype {
+	case "b", "r":
+		if t.elementOID != 0 {
+			return []uint32{t.elementOID}
+		}
+	case "c":
+		oids := make([]uint32, len(t.fields))
+		for i, f := range t.fields {
+			oids[i] = f.OID
+		}
+		return oids
+	case "d":
+		return []uint32{t.baseOID}
+	}
+
+	return nil
+}
+
+// newDataType builds the data type for t. All dependencies of t must already be registered on ci.
+func newDataType(ci *pgtype.ConnInfo, t *typeInfo) (pgtype.DataType, error) {
+	switch t.typtype {
+	case "b": // array
+		// A base type that is not an array such as one from an extension. Without further information the best that
+		// can be done is to treat it as text.
+		if t.elementOID == 0 {
+			return pgtype.DataType{Value: &pgtype.GenericText{}, Name: t.name, OID: t.oid}, nil
+		}
+
+		element, err := valueTranscoderForOID(ci, t.elementOID)
+		if err != nil {
+			return pgtype.DataType{}, fmt.Errorf("array element %v", err)
 		}
 
 		newElement := func() pgtype.ValueTranscoder {
 			return pgtype.NewValue(element).(pgtype.ValueTranscoder)
 		}
 
-		at := pgtype.NewArrayType(typeName, elementOID, newElement)
-		return pgtype.DataType{Value: at, Name: typeName, OID: oid}, nil
+		at := pgtype.NewArrayType(t.name, t.elementOID, newElement)
+		return pgtype.DataType{Value: at, Name: t.name, OID: t.oid}, nil
 	case "c": // composite
-		fields, err := GetCompositeFields(ctx, conn, oid)
+		ct, err := pgtype.NewCompositeType(t.name, t.fields, ci)
 		if err != nil {
 			return pgtype.DataType{}, err
 		}
-		ct, err := pgtype.NewCompositeType(typeName, fields, ci)
+		return pgtype.DataType{Value: ct, Name: t.name, OID: t.oid}, nil
+	case "e": // enum
+		return pgtype.DataType{Value: pgtype.NewEnumType(t.name, t.members), Name: t.name, OID: t.oid}, nil
+	case "r": // range
+		element, err := valueTranscoderForOID(ci, t.elementOID)
 		if err != nil {
-			return pgtype.DataType{}, err
+			return pgtype.DataType{}, fmt.Errorf("range element %v", err)
 		}
-		return pgtype.DataType{Value: ct, Name: typeName, OID: oid}, nil
-	case "e": // enum
-		members, err := GetEnumMembers(ctx, conn, oid)
+
+		newElement := func() pgtype.ValueTranscoder {
+			return pgtype.NewValue(element).(pgtype.ValueTranscoder)
+		}
+
+		rt := pgtype.NewRangeType(t.name, t.elementOID, newElement)
+		return pgtype.DataType{Value: rt, Name: t.name, OID: t.oid}, nil
+	case "d": // domain
+		base, err := valueTranscoderForOID(ci, t.baseOID)
 		if err != nil {
-			return pgtype.DataType{}, err
+			return pgtype.DataType{}, fmt.Errorf("domain base %v", err)
+		}
+
+		newBase := func() pgtype.ValueTranscoder {
+			return pgtype.NewValue(base).(pgtype.ValueTranscoder)
 		}
-		return pgtype.DataType{Value: pgtype.NewEnumType(typeName, members), Name: typeName, OID: oid}, nil
+
+		dmt := pgtype.NewDomainType(t.name, t.baseOID, newBase, nil)
+		return pgtype.DataType{Value: dmt, Name: t.name, OID: t.oid}, nil
 	default:
 		return pgtype.DataType{}, errors.New("unknown typtype")
 	}
 }
 
+func valueTranscoderForOID(ci *pgtype.ConnInfo, oid uint32) (pgtype.ValueTranscoder, error) {
+	dt, ok := ci.DataTypeForOID(oid)
+	if !ok {
+		return nil, fmt.Errorf("OID %d not registered", oid)
+	}
+
+	vt, ok := dt.Value.(pgtype.ValueTranscoder)
+	if !ok {
+		return nil, fmt.Errorf("OID %d not registered as ValueTranscoder", oid)
+	}
+
+	return vt, nil
+}
+
+// GetTypeName gets the name of the type by oid. The name is schema qualified if the type is not visible in the current
+// search path.
+func GetTypeName(ctx context.Context, conn Querier, oid uint32) (string, error) {
+	var typeName string
+
+	err := conn.QueryRow(ctx, `select case when pg_type_is_visible(t.oid) then quote_ident(t.typname)
+	else quote_ident(n.nspname) || '.' || quote_ident(t.typname) end
+from pg_type t
+	join pg_namespace n on n.oid=t.typnamespace
+where t.oid=$1`, oid).Scan(&typeName)
+	if err != nil {
+		return "", err
+	}
+
+	return typeName, nil
+}
+
 func GetArrayElementOID(ctx context.Context, conn Querier, oid uint32) (uint32, error) {
 	var typelem uint32
 
@@ -84,6 +236,44 @@ func GetArrayElementOID(ctx context.Context, conn Querier, oid uint32) (uint32,
 	return typelem, nil
 }
 
+// GetArrayOID gets the OID of the array type of the type by oid. It returns 0 if the type has no array type. The
+// result can be used with pgtype.ConnInfo.RegisterArrayType to register the array of a loaded composite, enum, or range
+// type.
+func GetArrayOID(ctx context.Context, conn Querier, oid uint32) (uint32, error) {
+	var typarray uint32
+
+	err := conn.QueryRow(ctx, "select typarray from pg_type where oid=$1", oid).Scan(&typarray)
+	if err != nil {
+		return 0, err
+	}
+
+	return typarray, nil
+}
+
+// GetRangeElementOID gets the OID of the subtype of the range type by oid.
+func GetRangeElementOID(ctx context.Context, conn Querier, oid uint32) (uint32, error) {
+	var rngsubtype uint32
+
+	err := conn.QueryRow(ctx, "select rngsubtype from pg_range where rngtypid=$1", oid).Scan(&rngsubtype)
+	if err != nil {
+		return 0, err
+	}
+
+	return rngsubtype, nil
+}
+
+// GetDomainBaseOID gets the OID of the base type of the domain type by oid.
+func GetDomainBaseOID(ctx context.Context, conn Querier, oid uint32) (uint32, error) {
+	var typbasetype uint32
+
+	err := conn.QueryRow(ctx, "select typbasetype from pg_type where oid=$1", oid).Scan(&typbasetype)
+	if err != nil {
+		return 0, err
+	}
+
+	return typbasetype, nil
+}
+
 // GetCompositeFields gets the fields of a composite type.
 func GetCompositeFields(ctx context.Context, conn Querier, oid uint32) ([]pgtype.CompositeTypeField, error) {
 	var typrelid uint32
@@ -98,10 +288,12 @@ func GetCompositeFields(ctx context.Context, conn Querier, oid uint32) ([]pgtype
 	rows, err := conn.Query(ctx, `select attname, atttypid
 from pg_attribute
 where attrelid=$1
+	and not attisdropped
 order by attnum`, typrelid)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var f pgtype.CompositeTypeField
@@ -127,6 +319,7 @@ func GetEnumMembers(ctx context.Context, conn Querier, oid uint32) ([]string, er
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var m string
@@ -0,0 +1,83 @@
+package pgxtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/pgxtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDataTypeLoadsDependencies(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `drop type if exists pgxtype_order;
+drop type if exists pgxtype_line_item;
+drop type if exists pgxtype_status;
+
+create type pgxtype_status as enum ('pending', 'shipped');
+
+create type pgxtype_line_item as (
+	sku text,
+	quantity int4,
+	status pgxtype_status
+);
+
+create type pgxtype_order as (
+	id int8,
+	status pgxtype_status,
+	items pgxtype_line_item[]
+);`)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), `drop type if exists pgxtype_order;
+drop type if exists pgxtype_line_item;
+drop type if exists pgxtype_status;`)
+
+	ci := conn.ConnInfo()
+	dt, err := pgxtype.LoadDataType(context.Background(), conn, ci, "pgxtype_order")
+	require.NoError(t, err)
+	ci.RegisterDataType(dt)
+
+	for _, name := range []string{"pgxtype_status", "pgxtype_line_item", "_pgxtype_line_item"} {
+		_, ok := ci.DataTypeForName(name)
+		require.Truef(t, ok, "%s not registered", name)
+	}
+
+	type lineItem struct {
+		SKU      string
+		Quantity int32
+		Status   string
+	}
+
+	type order struct {
+		ID     int64
+		Status string
+		Items  []lineItem
+	}
+
+	var result order
+	err = conn.QueryRow(context.Background(), `select row(1, 'shipped', array[row('abc', 2, 'pending')::pgxtype_line_item])::pgxtype_order`).Scan(&result)
+	require.NoError(t, err)
+	require.Equal(t, order{ID: 1, Status: "shipped", Items: []lineItem{{SKU: "abc", Quantity: 2, Status: "pending"}}}, result)
+}
+
+func TestLoadDataTypeDomain(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), `drop domain if exists pgxtype_positive_amount;
+
+create domain pgxtype_positive_amount as int8 check (value > 0);`)
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), "drop domain if exists pgxtype_positive_amount")
+
+	dt, err := pgxtype.LoadDataType(context.Background(), conn, conn.ConnInfo(), "pgxtype_positive_amount")
+	require.NoError(t, err)
+
+	domainType, ok := dt.Value.(*pgtype.DomainType)
+	require.True(t, ok)
+	require.EqualValues(t, pgtype.Int8OID, domainType.BaseOID())
+}
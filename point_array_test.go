@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestPointArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "point[]", []interface{}{
+		&pgtype.PointArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.PointArray{
+			Elements: []pgtype.Point{
+				{P: pgtype.Vec2{1.234, 5.6789012345}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.PointArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,51 @@
+package pgtype_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQCharArrayTranscode(t *testing.T) {
+	testutil.TestPgxSuccessfulTranscodeEqFunc(t, `"char"[]`, []interface{}{
+		&pgtype.QCharArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.QCharArray{
+			Elements: []pgtype.QChar{
+				{Int: math.MinInt8, Status: pgtype.Present},
+				{Int: 'r', Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.QCharArray{Status: pgtype.Null},
+	}, func(a, b interface{}) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+func TestQCharArrayBinaryRoundTrip(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	var src pgtype.QCharArray
+	require.NoError(t, src.Set([]int8{'r', 'v', math.MaxInt8}))
+
+	buf, err := src.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+
+	var dst pgtype.QCharArray
+	require.NoError(t, dst.DecodeBinary(ci, buf))
+	require.Equal(t, src, dst)
+
+	var result []int8
+	require.NoError(t, dst.AssignTo(&result))
+	require.Equal(t, []int8{'r', 'v', math.MaxInt8}, result)
+}
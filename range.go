@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"strings"
 )
 
 type BoundType byte
@@ -173,6 +174,19 @@ func rangeParseQuotedValue(buf *bytes.Buffer) (string, error) {
 	}
 }
 
+var quoteRangeBoundReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func quoteRangeBound(src string) string {
+	return `"` + quoteRangeBoundReplacer.Replace(src) + `"`
+}
+
+func quoteRangeBoundIfNeeded(src string) string {
+	if src == "" || isSpace(src[0]) || isSpace(src[len(src)-1]) || strings.ContainsAny(src, `()[],"\`) {
+		return quoteRangeBound(src)
+	}
+	return src
+}
+
 type UntypedBinaryRange struct {
 	Lower     []byte
 	Upper     []byte
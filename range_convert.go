@@ -0,0 +1,298 @@
+package pgtype
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var boundTypeType = reflect.TypeOf(BoundType(0))
+
+// genericRange is a range read from a Go value that is not a pgtype range type. Lower and Upper are only meaningful
+// when the corresponding bound type is Inclusive or Exclusive.
+type genericRange struct {
+	Lower     interface{}
+	Upper     interface{}
+	LowerType BoundType
+	UpperType BoundType
+}
+
+// rangeStructFields locates the fields of a struct that are used to represent a range. Lower and Upper are required.
+// LowerType / UpperType (BoundType), LowerInclusive / UpperInclusive (bool), and Empty (bool) are optional.
+type rangeStructFields struct {
+	lower, upper                   int
+	lowerType, upperType           int
+	lowerInclusive, upperInclusive int
+	empty                          int
+}
+
+func findRangeStructFields(t reflect.Type) (*rangeStructFields, bool) {
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	fields := &rangeStructFields{lower: -1, upper: -1, lowerType: -1, upperType: -1, lowerInclusive: -1, upperInclusive: -1, empty: -1}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		switch sf.Name {
+		case "Lower":
+			fields.lower = i
+		case "Upper":
+			fields.upper = i
+		case "LowerType":
+			if sf.Type == boundTypeType {
+				fields.lowerType = i
+			}
+		case "UpperType":
+			if sf.Type == boundTypeType {
+				fields.upperType = i
+			}
+		case "LowerInclusive":
+			if sf.Type.Kind() == reflect.Bool {
+				fields.lowerInclusive = i
+			}
+		case "UpperInclusive":
+			if sf.Type.Kind() == reflect.Bool {
+				fields.upperInclusive = i
+			}
+		case "Empty":
+			if sf.Type.Kind() == reflect.Bool {
+				fields.empty = i
+			}
+		}
+	}
+
+	if fields.lower == -1 || fields.upper == -1 {
+		return nil, false
+	}
+
+	return fields, true
+}
+
+func isNilBound(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// rangeFromGoValue converts src to a genericRange. src may be a struct (or pointer to struct) with Lower and Upper
+// fields as described by rangeStructFields or a two element array. A non-zero LowerType or UpperType field sets the bound
+// type. Otherwise a LowerInclusive or UpperInclusive field selects Inclusive when true and Exclusive when false, so a
+// false LowerInclusive makes the lower bound Exclusive. A bound with neither field defaults to the [lower,upper) canonical
+// form of discrete ranges, as do both bounds of an array. A nil pointer or interface bound is unbounded.
+//
+// ok is false if src is not a recognized shape. If src is a nil pointer then ok is true and the returned range is nil.
+func rangeFromGoValue(src interface{}) (gr *genericRange, ok bool, err error) {
+	value := reflect.ValueOf(src)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			switch value.Type().Elem().Kind() {
+			case reflect.Struct, reflect.Array:
+				return nil, true, nil
+			}
+			return nil, false, nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Array:
+		if value.Len() != 2 {
+			return nil, false, nil
+		}
+
+		gr = &genericRange{LowerType: Inclusive, UpperType: Exclusive}
+		if lower := value.Index(0); isNilBound(lower) {
+			gr.LowerType = Unbounded
+		} else {
+			gr.Lower = lower.Interface()
+		}
+		if upper := value.Index(1); isNilBound(upper) {
+			gr.UpperType = Unbounded
+		} else {
+			gr.Upper = upper.Interface()
+		}
+		return gr, true, nil
+	case reflect.Struct:
+		fields, ok := findRangeStructFields(value.Type())
+		if !ok {
+			return nil, false, nil
+		}
+
+		if fields.empty != -1 && value.Field(fields.empty).Bool() {
+			return &genericRange{LowerType: Empty, UpperType: Empty}, true, nil
+		}
+
+		gr = &genericRange{LowerType: Inclusive, UpperType: Exclusive}
+
+		if fields.lowerType != -1 && value.Field(fields.lowerType).Uint() != 0 {
+			gr.LowerType = BoundType(value.Field(fields.lowerType).Uint())
+		} else if fields.lowerInclusive != -1 && !value.Field(fields.lowerInclusive).Bool() {
+			gr.LowerType = Exclusive
+		}
+
+		if fields.upperType != -1 && value.Field(fields.upperType).Uint() != 0 {
+			gr.UpperType = BoundType(value.Field(fields.upperType).Uint())
+		} else if fields.upperInclusive != -1 && value.Field(fields.upperInclusive).Bool() {
+			gr.UpperType = Inclusive
+		}
+
+		if gr.LowerType == Empty || gr.UpperType == Empty {
+			return &genericRange{LowerType: Empty, UpperType: Empty}, true, nil
+		}
+
+		if lower := value.Field(fields.lower); isNilBound(lower) {
+			gr.LowerType = Unbounded
+		} else if gr.LowerType != Unbounded {
+			gr.Lower = lower.Interface()
+		}
+
+		if upper := value.Field(fields.upper); isNilBound(upper) {
+			gr.UpperType = Unbounded
+		} else if gr.UpperType != Unbounded {
+			gr.Upper = upper.Interface()
+		}
+
+		switch gr.LowerType {
+		case Inclusive, Exclusive, Unbounded:
+		default:
+			return nil, true, fmt.Errorf("invalid LowerType %v in %T", gr.LowerType, src)
+		}
+
+		switch gr.UpperType {
+		case Inclusive, Exclusive, Unbounded:
+		default:
+			return nil, true, fmt.Errorf("invalid UpperType %v in %T", gr.UpperType, src)
+		}
+
+		return gr, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// assignRangeToGoValue assigns a present range to dst. dst may be a pointer to a struct or a pointer to a two element
+// array as accepted by rangeFromGoValue. Unbounded bounds are assigned as nil if the destination is a pointer or
+// interface, or as the zero value if the struct can represent the bound type. Otherwise an error is returned rather
+// than silently losing information. isRange is false if dst is not a recognized shape.
+func assignRangeToGoValue(lower, upper Value, lowerType, upperType BoundType, dst interface{}) (isRange bool, err error) {
+	dstPtr := reflect.ValueOf(dst)
+	if dstPtr.Kind() != reflect.Ptr || dstPtr.IsNil() {
+		return false, nil
+	}
+
+	dstVal := dstPtr.Elem()
+
+	switch dstVal.Kind() {
+	case reflect.Array:
+		if dstVal.Len() != 2 {
+			return false, nil
+		}
+
+		if lowerType == Empty {
+			return true, fmt.Errorf("cannot assign empty range to %T", dst)
+		}
+		if lowerType == Exclusive {
+			return true, fmt.Errorf("cannot assign range with exclusive lower bound to %T", dst)
+		}
+		if upperType == Inclusive {
+			return true, fmt.Errorf("cannot assign range with inclusive upper bound to %T", dst)
+		}
+
+		result := reflect.New(dstVal.Type()).Elem()
+		if err := assignRangeBound(lower, lowerType, result.Index(0), dst); err != nil {
+			return true, err
+		}
+		if err := assignRangeBound(upper, upperType, result.Index(1), dst); err != nil {
+			return true, err
+		}
+		dstVal.Set(result)
+
+		return true, nil
+	case reflect.Struct:
+		fields, ok := findRangeStructFields(dstVal.Type())
+		if !ok {
+			return false, nil
+		}
+
+		result := reflect.New(dstVal.Type()).Elem()
+
+		if lowerType == Empty {
+			switch {
+			case fields.empty != -1:
+				result.Field(fields.empty).SetBool(true)
+			case fields.lowerType != -1 && fields.upperType != -1:
+				result.Field(fields.lowerType).Set(reflect.ValueOf(Empty))
+				result.Field(fields.upperType).Set(reflect.ValueOf(Empty))
+			default:
+				return true, fmt.Errorf("cannot assign empty range to %T without Empty or LowerType and UpperType fields", dst)
+			}
+			dstVal.Set(result)
+			return true, nil
+		}
+
+		if fields.lowerType != -1 {
+			result.Field(fields.lowerType).Set(reflect.ValueOf(lowerType))
+		} else if fields.lowerInclusive != -1 {
+			result.Field(fields.lowerInclusive).SetBool(lowerType == Inclusive)
+		} else if lowerType == Exclusive {
+			return true, fmt.Errorf("cannot assign range with exclusive lower bound to %T without LowerType or LowerInclusive field", dst)
+		}
+
+		if fields.upperType != -1 {
+			result.Field(fields.upperType).Set(reflect.ValueOf(upperType))
+		} else if fields.upperInclusive != -1 {
+			result.Field(fields.upperInclusive).SetBool(upperType == Inclusive)
+		} else if upperType == Inclusive {
+			return true, fmt.Errorf("cannot assign range with inclusive upper bound to %T without UpperType or UpperInclusive field", dst)
+		}
+
+		lowerField := result.Field(fields.lower)
+		if lowerType == Unbounded && fields.lowerType != -1 {
+			// Unbounded is recorded in LowerType. Lower is left as the zero value.
+		} else if err := assignRangeBound(lower, lowerType, lowerField, dst); err != nil {
+			return true, err
+		}
+
+		upperField := result.Field(fields.upper)
+		if upperType == Unbounded && fields.upperType != -1 {
+			// Unbounded is recorded in UpperType. Upper is left as the zero value.
+		} else if err := assignRangeBound(upper, upperType, upperField, dst); err != nil {
+			return true, err
+		}
+
+		dstVal.Set(result)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func assignRangeBound(bound Value, boundType BoundType, dst reflect.Value, rangeDst interface{}) error {
+	if boundType == Unbounded {
+		switch dst.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		return fmt.Errorf("cannot assign unbounded range bound to %v in %T", dst.Type(), rangeDst)
+	}
+
+	if bound == nil {
+		return fmt.Errorf("cannot assign missing range bound to %v in %T", dst.Type(), rangeDst)
+	}
+
+	if dst.Kind() == reflect.Interface {
+		if v := bound.Get(); v != nil {
+			dst.Set(reflect.ValueOf(v))
+		}
+		return nil
+	}
+
+	return assignToOrSet(bound, dst.Addr().Interface())
+}
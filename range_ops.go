@@ -0,0 +1,516 @@
+package pgtype
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// The range operations below follow the implementation of the range operators in PostgreSQL's
+// src/backend/utils/adt/rangetypes.c so that results match what the server would compute.
+
+var errRangeUnionNotContiguous = errors.New("result of range union would not be contiguous")
+var errRangeDifferenceNotContiguous = errors.New("result of range difference would not be contiguous")
+
+// rangeBound is one bound of a range in a form independent of the element type.
+type rangeBound struct {
+	value     interface{} // only valid if infinite is false
+	inclusive bool
+	infinite  bool
+	lower     bool
+}
+
+// rangeBounds is a range in a form independent of the element type.
+type rangeBounds struct {
+	lower rangeBound
+	upper rangeBound
+	empty bool
+}
+
+func newRangeBounds(lower, upper interface{}, lowerType, upperType BoundType) rangeBounds {
+	if lowerType == Empty || upperType == Empty {
+		return rangeBounds{empty: true}
+	}
+
+	rb := rangeBounds{
+		lower: rangeBound{inclusive: lowerType == Inclusive, infinite: lowerType == Unbounded, lower: true},
+		upper: rangeBound{inclusive: upperType == Inclusive, infinite: upperType == Unbounded, lower: false},
+	}
+	if !rb.lower.infinite {
+		rb.lower.value = lower
+	}
+	if !rb.upper.infinite {
+		rb.upper.value = upper
+	}
+
+	return rb
+}
+
+func (rb rangeBounds) lowerType() BoundType {
+	return rb.lower.boundType()
+}
+
+func (rb rangeBounds) upperType() BoundType {
+	return rb.upper.boundType()
+}
+
+func (b rangeBound) boundType() BoundType {
+	switch {
+	case b.infinite:
+		return Unbounded
+	case b.inclusive:
+		return Inclusive
+	default:
+		return Exclusive
+	}
+}
+
+// rangeOps implements the range operators for a particular element type.
+type rangeOps struct {
+	// compare returns -1, 0, or 1 if a is less than, equal to, or greater than b.
+	compare func(a, b interface{}) int
+
+	// canonicalize is nil for continuous ranges. For discrete ranges it converts a non-empty range to its canonical
+	// form. It may return an empty range.
+	canonicalize func(rb rangeBounds) rangeBounds
+}
+
+// cmpBoundValues compares the values of two bounds ignoring inclusivity. It corresponds to range_cmp_bound_values.
+func (ops rangeOps) cmpBoundValues(b1, b2 rangeBound) int {
+	if b1.infinite && b2.infinite {
+		if b1.lower == b2.lower {
+			return 0
+		} else if b1.lower {
+			return -1
+		}
+		return 1
+	} else if b1.infinite {
+		if b1.lower {
+			return -1
+		}
+		return 1
+	} else if b2.infinite {
+		if b2.lower {
+			return 1
+		}
+		return -1
+	}
+
+	return ops.compare(b1.value, b2.value)
+}
+
+// cmpBounds compares two bounds taking inclusivity and whether they are lower or upper bounds into account. It
+// corresponds to range_cmp_bounds.
+func (ops rangeOps) cmpBounds(b1, b2 rangeBound) int {
+	if b1.infinite || b2.infinite {
+		return ops.cmpBoundValues(b1, b2)
+	}
+
+	result := ops.compare(b1.value, b2.value)
+	if result == 0 {
+		if !b1.inclusive && !b2.inclusive {
+			if b1.lower == b2.lower {
+				return 0
+			} else if b1.lower {
+				return 1
+			}
+			return -1
+		} else if !b1.inclusive {
+			if b1.lower {
+				return 1
+			}
+			return -1
+		} else if !b2.inclusive {
+			if b2.lower {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return result
+}
+
+// makeRange builds a range from lower and upper the same way the server does when constructing a range value. It
+// corresponds to make_range.
+func (ops rangeOps) makeRange(lower, upper rangeBound) rangeBounds {
+	lower.lower = true
+	upper.lower = false
+	if lower.infinite {
+		lower.inclusive = false
+		lower.value = nil
+	}
+	if upper.infinite {
+		upper.inclusive = false
+		upper.value = nil
+	}
+
+	if ops.isEmpty(lower, upper) {
+		return rangeBounds{empty: true}
+	}
+
+	rb := rangeBounds{lower: lower, upper: upper}
+	if ops.canonicalize != nil {
+		rb = ops.canonicalize(rb)
+		if ops.isEmpty(rb.lower, rb.upper) {
+			return rangeBounds{empty: true}
+		}
+	}
+
+	return rb
+}
+
+// isEmpty reports whether a range with bounds lower and upper contains no points. Like the server, a range whose lower
+// bound is greater than its upper bound is also treated as empty.
+func (ops rangeOps) isEmpty(lower, upper rangeBound) bool {
+	if lower.infinite || upper.infinite {
+		return false
+	}
+
+	cmp := ops.compare(lower.value, upper.value)
+	return cmp > 0 || (cmp == 0 && !(lower.inclusive && upper.inclusive))
+}
+
+// canonical returns r with empty ranges detected and, for discrete ranges, the bounds converted to canonical form.
+func (ops rangeOps) canonical(r rangeBounds) rangeBounds {
+	if r.empty {
+		return r
+	}
+
+	return ops.makeRange(r.lower, r.upper)
+}
+
+// equal corresponds to range_eq_internal applied to the canonical forms of r1 and r2.
+func (ops rangeOps) equal(r1, r2 rangeBounds) bool {
+	r1, r2 = ops.canonical(r1), ops.canonical(r2)
+
+	if r1.empty || r2.empty {
+		return r1.empty && r2.empty
+	}
+
+	return ops.cmpBounds(r1.lower, r2.lower) == 0 && ops.cmpBounds(r1.upper, r2.upper) == 0
+}
+
+// containsElement corresponds to range_contains_elem_internal.
+func (ops rangeOps) containsElement(r rangeBounds, value interface{}) bool {
+	if r.empty {
+		return false
+	}
+
+	if !r.lower.infinite {
+		cmp := ops.compare(r.lower.value, value)
+		if cmp > 0 || (cmp == 0 && !r.lower.inclusive) {
+			return false
+		}
+	}
+
+	if !r.upper.infinite {
+		cmp := ops.compare(r.upper.value, value)
+		if cmp < 0 || (cmp == 0 && !r.upper.inclusive) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// contains corresponds to range_contains_internal.
+func (ops rangeOps) contains(r1, r2 rangeBounds) bool {
+	if r2.empty {
+		return true
+	} else if r1.empty {
+		return false
+	}
+
+	return ops.cmpBounds(r1.lower, r2.lower) <= 0 && ops.cmpBounds(r1.upper, r2.upper) >= 0
+}
+
+// overlaps corresponds to range_overlaps_internal.
+func (ops rangeOps) overlaps(r1, r2 rangeBounds) bool {
+	if r1.empty || r2.empty {
+		return false
+	}
+
+	if ops.cmpBounds(r1.lower, r2.lower) >= 0 && ops.cmpBounds(r1.lower, r2.upper) <= 0 {
+		return true
+	}
+
+	if ops.cmpBounds(r2.lower, r1.lower) >= 0 && ops.cmpBounds(r2.lower, r1.upper) <= 0 {
+		return true
+	}
+
+	return false
+}
+
+// boundsAdjacent reports whether upper bound a and lower bound b are adjacent. It corresponds to bounds_adjacent.
+func (ops rangeOps) boundsAdjacent(a, b rangeBound) bool {
+	cmp := ops.cmpBoundValues(a, b)
+	if cmp < 0 {
+		// In a continuous range there are assumed to be points between the bounds.
+		if ops.canonicalize == nil {
+			return false
+		}
+
+		// In a discrete range make a range between the bounds and see if it is empty.
+		a.inclusive = !a.inclusive
+		b.inclusive = !b.inclusive
+		return ops.makeRange(a, b).empty
+	} else if cmp == 0 {
+		return a.inclusive != b.inclusive
+	}
+
+	return false
+}
+
+// adjacent corresponds to range_adjacent_internal.
+func (ops rangeOps) adjacent(r1, r2 rangeBounds) bool {
+	if r1.empty || r2.empty {
+		return false
+	}
+
+	return ops.boundsAdjacent(r1.upper, r2.lower) || ops.boundsAdjacent(r2.upper, r1.lower)
+}
+
+// union corresponds to range_union_internal with strict set.
+func (ops rangeOps) union(r1, r2 rangeBounds) (rangeBounds, error) {
+	if r1.empty {
+		return r2, nil
+	}
+	if r2.empty {
+		return r1, nil
+	}
+
+	if !ops.overlaps(r1, r2) && !ops.adjacent(r1, r2) {
+		return rangeBounds{}, errRangeUnionNotContiguous
+	}
+
+	lower := r1.lower
+	if ops.cmpBounds(r1.lower, r2.lower) > 0 {
+		lower = r2.lower
+	}
+
+	upper := r1.upper
+	if ops.cmpBounds(r1.upper, r2.upper) < 0 {
+		upper = r2.upper
+	}
+
+	return ops.makeRange(lower, upper), nil
+}
+
+// intersect corresponds to range_intersect_internal.
+func (ops rangeOps) intersect(r1, r2 rangeBounds) rangeBounds {
+	if r1.empty || r2.empty || !ops.overlaps(r1, r2) {
+		return rangeBounds{empty: true}
+	}
+
+	lower := r1.lower
+	if ops.cmpBounds(r1.lower, r2.lower) < 0 {
+		lower = r2.lower
+	}
+
+	upper := r1.upper
+	if ops.cmpBounds(r1.upper, r2.upper) > 0 {
+		upper = r2.upper
+	}
+
+	return ops.makeRange(lower, upper)
+}
+
+// difference corresponds to range_minus_internal.
+func (ops rangeOps) difference(r1, r2 rangeBounds) (rangeBounds, error) {
+	if r1.empty || r2.empty {
+		return r1, nil
+	}
+
+	cmpL1L2 := ops.cmpBounds(r1.lower, r2.lower)
+	cmpL1U2 := ops.cmpBounds(r1.lower, r2.upper)
+	cmpU1L2 := ops.cmpBounds(r1.upper, r2.lower)
+	cmpU1U2 := ops.cmpBounds(r1.upper, r2.upper)
+
+	if cmpL1L2 < 0 && cmpU1U2 > 0 {
+		return rangeBounds{}, errRangeDifferenceNotContiguous
+	}
+
+	if cmpL1U2 > 0 || cmpU1L2 < 0 {
+		return r1, nil
+	}
+
+	if cmpL1L2 >= 0 && cmpU1U2 <= 0 {
+		return rangeBounds{empty: true}, nil
+	}
+
+	if cmpL1L2 <= 0 && cmpU1L2 >= 0 && cmpU1U2 <= 0 {
+		upper := r2.lower
+		upper.inclusive = !upper.inclusive
+		return ops.makeRange(r1.lower, upper), nil
+	}
+
+	if cmpL1L2 >= 0 && cmpU1U2 >= 0 && cmpL1U2 <= 0 {
+		lower := r2.upper
+		lower.inclusive = !lower.inclusive
+		return ops.makeRange(lower, r1.upper), nil
+	}
+
+	return rangeBounds{}, errors.New("unexpected case in range difference")
+}
+
+// canonicalizeDiscrete converts the bounds of rb to the [lower,upper) form used by the server for discrete ranges.
+// next returns the value following v and false if there is no such value. A bound whose value has no successor is
+// left unchanged as the server would reject it anyway.
+func canonicalizeDiscrete(rb rangeBounds, next func(v interface{}) (interface{}, bool)) rangeBounds {
+	if !rb.lower.infinite && !rb.lower.inclusive {
+		if v, ok := next(rb.lower.value); ok {
+			rb.lower.value = v
+			rb.lower.inclusive = true
+		}
+	}
+
+	if !rb.upper.infinite && rb.upper.inclusive {
+		if v, ok := next(rb.upper.value); ok {
+			rb.upper.value = v
+			rb.upper.inclusive = false
+		}
+	}
+
+	return rb
+}
+
+// canonicalizeInt4 corresponds to int4range_canonical.
+func canonicalizeInt4(rb rangeBounds) rangeBounds {
+	return canonicalizeDiscrete(rb, func(v interface{}) (interface{}, bool) {
+		n := v.(Int4)
+		if n.Int == math.MaxInt32 {
+			return nil, false
+		}
+		n.Int++
+		return n, true
+	})
+}
+
+// canonicalizeInt8 corresponds to int8range_canonical.
+func canonicalizeInt8(rb rangeBounds) rangeBounds {
+	return canonicalizeDiscrete(rb, func(v interface{}) (interface{}, bool) {
+		n := v.(Int8)
+		if n.Int == math.MaxInt64 {
+			return nil, false
+		}
+		n.Int++
+		return n, true
+	})
+}
+
+// canonicalizeDate corresponds to daterange_canonical. Infinite dates are not adjusted.
+func canonicalizeDate(rb rangeBounds) rangeBounds {
+	return canonicalizeDiscrete(rb, func(v interface{}) (interface{}, bool) {
+		d := v.(Date)
+		if d.InfinityModifier != None {
+			return nil, false
+		}
+		d.Time = d.Time.AddDate(0, 0, 1)
+		return d, true
+	})
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareInfinityModifier(a, b InfinityModifier) int {
+	return compareInt64(int64(a), int64(b))
+}
+
+func compareInt4(a, b interface{}) int {
+	return compareInt64(int64(a.(Int4).Int), int64(b.(Int4).Int))
+}
+
+func compareInt8(a, b interface{}) int {
+	return compareInt64(a.(Int8).Int, b.(Int8).Int)
+}
+
+// compareNumeric orders NaN above all other values as PostgreSQL does.
+func compareNumeric(a, b interface{}) int {
+	an, bn := a.(Numeric), b.(Numeric)
+
+	if an.NaN || bn.NaN {
+		switch {
+		case an.NaN && bn.NaN:
+			return 0
+		case an.NaN:
+			return 1
+		default:
+			return -1
+		}
+	}
+
+	aInt, bInt := an.Int, bn.Int
+	if aInt == nil {
+		aInt = big.NewInt(0)
+	}
+	if bInt == nil {
+		bInt = big.NewInt(0)
+	}
+
+	if an.Exp > bn.Exp {
+		aInt = new(big.Int).Mul(aInt, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(an.Exp-bn.Exp)), nil))
+	} else if bn.Exp > an.Exp {
+		bInt = new(big.Int).Mul(bInt, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(bn.Exp-an.Exp)), nil))
+	}
+
+	return aInt.Cmp(bInt)
+}
+
+func compareDate(a, b interface{}) int {
+	ad, bd := a.(Date), b.(Date)
+	if ad.InfinityModifier != None || bd.InfinityModifier != None {
+		return compareInfinityModifier(ad.InfinityModifier, bd.InfinityModifier)
+	}
+
+	ay, am, aday := ad.Time.Date()
+	by, bm, bday := bd.Time.Date()
+	if c := compareInt64(int64(ay), int64(by)); c != 0 {
+		return c
+	}
+	if c := compareInt64(int64(am), int64(bm)); c != 0 {
+		return c
+	}
+	return compareInt64(int64(aday), int64(bday))
+}
+
+func compareTimestamp(a, b interface{}) int {
+	at, bt := a.(Timestamp), b.(Timestamp)
+	if at.InfinityModifier != None || bt.InfinityModifier != None {
+		return compareInfinityModifier(at.InfinityModifier, bt.InfinityModifier)
+	}
+
+	switch {
+	case at.Time.Before(bt.Time):
+		return -1
+	case at.Time.After(bt.Time):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareTimestamptz(a, b interface{}) int {
+	at, bt := a.(Timestamptz), b.(Timestamptz)
+	if at.InfinityModifier != None || bt.InfinityModifier != None {
+		return compareInfinityModifier(at.InfinityModifier, bt.InfinityModifier)
+	}
+
+	switch {
+	case at.Time.Before(bt.Time):
+		return -1
+	case at.Time.After(bt.Time):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,334 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func mustNumrange(t *testing.T, s string) pgtype.Numrange {
+	var r pgtype.Numrange
+	err := r.Set(s)
+	require.NoError(t, err)
+	return r
+}
+
+func numrangeString(t *testing.T, r pgtype.Numrange) string {
+	buf, err := r.EncodeText(nil, nil)
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestRangeContainsRange(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "[1,5)", b: "[2,3)", expected: true},
+		{a: "[1,5)", b: "[1,5)", expected: true},
+		{a: "[1,5)", b: "[1,5]", expected: false},
+		{a: "(1,5)", b: "[1,5)", expected: false},
+		{a: "(,)", b: "[1,5)", expected: true},
+		{a: "[1,5)", b: "(,5)", expected: false},
+		{a: "[1,5)", b: "empty", expected: true},
+		{a: "empty", b: "empty", expected: true},
+		{a: "empty", b: "[1,2)", expected: false},
+	}
+
+	for i, tt := range tests {
+		a, b := mustNumrange(t, tt.a), mustNumrange(t, tt.b)
+		require.Equalf(t, tt.expected, a.ContainsRange(b), "%d. %s @> %s", i, tt.a, tt.b)
+	}
+}
+
+func TestRangeContainsElement(t *testing.T) {
+	tests := []struct {
+		r        string
+		elem     string
+		expected bool
+	}{
+		{r: "[1,5)", elem: "1", expected: true},
+		{r: "[1,5)", elem: "5", expected: false},
+		{r: "[1,5]", elem: "5", expected: true},
+		{r: "(1,5)", elem: "1", expected: false},
+		{r: "(1,5)", elem: "1.5", expected: true},
+		{r: "(,5)", elem: "-100", expected: true},
+		{r: "[5,)", elem: "4.99", expected: false},
+		{r: "empty", elem: "1", expected: false},
+	}
+
+	for i, tt := range tests {
+		r := mustNumrange(t, tt.r)
+		var elem pgtype.Numeric
+		err := elem.Set(tt.elem)
+		require.NoError(t, err)
+		require.Equalf(t, tt.expected, r.ContainsElement(elem), "%d. %s @> %s", i, tt.r, tt.elem)
+	}
+}
+
+func TestRangeOverlapsAndAdjacent(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		overlaps bool
+		adjacent bool
+	}{
+		{a: "[1,5)", b: "[5,6)", overlaps: false, adjacent: true},
+		{a: "[1,5]", b: "[5,6)", overlaps: true, adjacent: false},
+		{a: "[1,5]", b: "(5,6)", overlaps: false, adjacent: true},
+		{a: "[1,5)", b: "(5,6)", overlaps: false, adjacent: false},
+		{a: "[1,5)", b: "[3,8)", overlaps: true, adjacent: false},
+		{a: "[5,6)", b: "[1,5)", overlaps: false, adjacent: true},
+		{a: "(,1)", b: "[1,)", overlaps: false, adjacent: true},
+		{a: "(,)", b: "[1,2)", overlaps: true, adjacent: false},
+		{a: "empty", b: "[1,2)", overlaps: false, adjacent: false},
+		{a: "empty", b: "empty", overlaps: false, adjacent: false},
+	}
+
+	for i, tt := range tests {
+		a, b := mustNumrange(t, tt.a), mustNumrange(t, tt.b)
+		require.Equalf(t, tt.overlaps, a.Overlaps(b), "%d. %s && %s", i, tt.a, tt.b)
+		require.Equalf(t, tt.adjacent, a.Adjacent(b), "%d. %s -|- %s", i, tt.a, tt.b)
+	}
+}
+
+func TestRangeUnion(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected string
+		err      bool
+	}{
+		{a: "[1,5)", b: "[5,6)", expected: "[1,6)"},
+		{a: "[1,5)", b: "[3,8]", expected: "[1,8]"},
+		{a: "(,3]", b: "[2,10)", expected: "(,10)"},
+		{a: "[1,5)", b: "empty", expected: "[1,5)"},
+		{a: "empty", b: "empty", expected: "empty"},
+		{a: "[1,5)", b: "(5,6)", err: true},
+		{a: "[1,5)", b: "[7,8)", err: true},
+	}
+
+	for i, tt := range tests {
+		a, b := mustNumrange(t, tt.a), mustNumrange(t, tt.b)
+		result, err := a.Union(b)
+		if tt.err {
+			require.Errorf(t, err, "%d. %s + %s", i, tt.a, tt.b)
+			continue
+		}
+		require.NoErrorf(t, err, "%d. %s + %s", i, tt.a, tt.b)
+		require.Equalf(t, numrangeString(t, mustNumrange(t, tt.expected)), numrangeString(t, result), "%d. %s + %s", i, tt.a, tt.b)
+	}
+}
+
+func TestRangeIntersect(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected string
+	}{
+		{a: "[1,5)", b: "[3,8)", expected: "[3,5)"},
+		{a: "[1,5)", b: "[5,8)", expected: "empty"},
+		{a: "[1,5]", b: "[5,8)", expected: "[5,5]"},
+		{a: "(,5)", b: "(,3]", expected: "(,3]"},
+		{a: "(,)", b: "(2,3)", expected: "(2,3)"},
+		{a: "[1,5)", b: "empty", expected: "empty"},
+	}
+
+	for i, tt := range tests {
+		a, b := mustNumrange(t, tt.a), mustNumrange(t, tt.b)
+		require.Equalf(t, numrangeString(t, mustNumrange(t, tt.expected)), numrangeString(t, a.Intersect(b)), "%d. %s * %s", i, tt.a, tt.b)
+	}
+}
+
+func TestRangeDifference(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected string
+		err      bool
+	}{
+		{a: "[1,10)", b: "[5,20)", expected: "[1,5)"},
+		{a: "[1,10)", b: "[0,5]", expected: "(5,10)"},
+		{a: "[1,10)", b: "[20,30)", expected: "[1,10)"},
+		{a: "[1,10)", b: "[0,20)", expected: "empty"},
+		{a: "[1,10]", b: "[1,10]", expected: "empty"},
+		{a: "[1,10]", b: "[1,10)", expected: "[10,10]"},
+		{a: "(,)", b: "[5,)", expected: "(,5)"},
+		{a: "[1,10)", b: "empty", expected: "[1,10)"},
+		{a: "empty", b: "[1,10)", expected: "empty"},
+		{a: "[1,10)", b: "[3,4)", err: true},
+	}
+
+	for i, tt := range tests {
+		a, b := mustNumrange(t, tt.a), mustNumrange(t, tt.b)
+		result, err := a.Difference(b)
+		if tt.err {
+			require.Errorf(t, err, "%d. %s - %s", i, tt.a, tt.b)
+			continue
+		}
+		require.NoErrorf(t, err, "%d. %s - %s", i, tt.a, tt.b)
+		require.Equalf(t, numrangeString(t, mustNumrange(t, tt.expected)), numrangeString(t, result), "%d. %s - %s", i, tt.a, tt.b)
+	}
+}
+
+func TestRangeOperationsWithNull(t *testing.T) {
+	a := mustNumrange(t, "[1,5)")
+	null := pgtype.Numrange{Status: pgtype.Null}
+
+	require.False(t, a.Overlaps(null))
+	require.False(t, null.ContainsRange(a))
+
+	result, err := a.Union(null)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Null, result.Status)
+
+	require.Equal(t, pgtype.Null, null.Intersect(a).Status)
+}
+
+func TestRangeOperationsWithInfinity(t *testing.T) {
+	r := pgtype.Daterange{
+		Lower:     pgtype.Date{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		Upper:     pgtype.Date{InfinityModifier: pgtype.Infinity, Status: pgtype.Present},
+		LowerType: pgtype.Inclusive,
+		UpperType: pgtype.Exclusive,
+		Status:    pgtype.Present,
+	}
+
+	require.True(t, r.ContainsElement(pgtype.Date{Time: time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}))
+	require.False(t, r.ContainsElement(pgtype.Date{InfinityModifier: pgtype.Infinity, Status: pgtype.Present}))
+	require.False(t, r.ContainsElement(pgtype.Date{InfinityModifier: pgtype.NegativeInfinity, Status: pgtype.Present}))
+
+	unbounded := pgtype.Daterange{
+		Lower:     pgtype.Date{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+		LowerType: pgtype.Inclusive,
+		UpperType: pgtype.Unbounded,
+		Status:    pgtype.Present,
+	}
+	require.True(t, unbounded.ContainsRange(r))
+	require.False(t, r.ContainsRange(unbounded))
+}
+
+func TestRangeCanonical(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{source: "[1,5]", expected: "[1,6)"},
+		{source: "(1,5]", expected: "[2,6)"},
+		{source: "(1,5)", expected: "[2,5)"},
+		{source: "[1,5)", expected: "[1,5)"},
+		{source: "(,5]", expected: "(,6)"},
+		{source: "(1,)", expected: "[2,)"},
+		{source: "(,)", expected: "(,)"},
+		{source: "[5,5)", expected: "empty"},
+		{source: "(5,6)", expected: "empty"},
+		{source: "[5,5]", expected: "[5,6)"},
+		{source: "empty", expected: "empty"},
+		{source: "[1,2147483647]", expected: "[1,2147483647]"},
+	}
+
+	for i, tt := range tests {
+		var r pgtype.Int4range
+		err := r.Set(tt.source)
+		require.NoError(t, err)
+
+		buf, err := r.Canonical().EncodeText(nil, nil)
+		require.NoError(t, err)
+		require.Equalf(t, tt.expected, string(buf), "%d. %s", i, tt.source)
+
+		err = r.SetCanonical(tt.source)
+		require.NoError(t, err)
+		buf, err = r.EncodeText(nil, nil)
+		require.NoError(t, err)
+		require.Equalf(t, tt.expected, string(buf), "%d. %s", i, tt.source)
+	}
+
+	null := pgtype.Int4range{Status: pgtype.Null}
+	require.Equal(t, null, null.Canonical())
+}
+
+func TestRangeCanonicalContinuous(t *testing.T) {
+	require.Equal(t, "empty", numrangeString(t, mustNumrange(t, "[5,5)").Canonical()))
+	require.Equal(t, "empty", numrangeString(t, mustNumrange(t, "(5,5]").Canonical()))
+	require.Equal(t, numrangeString(t, mustNumrange(t, "(1,5]")), numrangeString(t, mustNumrange(t, "(1,5]").Canonical()))
+}
+
+func TestDaterangeCanonical(t *testing.T) {
+	var r pgtype.Daterange
+	err := r.SetCanonical("(2020-01-31,2020-02-29]")
+	require.NoError(t, err)
+	buf, err := r.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "[2020-02-01,2020-03-01)", string(buf))
+
+	err = r.SetCanonical("(2020-01-01,infinity]")
+	require.NoError(t, err)
+	buf, err = r.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "[2020-01-02,infinity]", string(buf))
+}
+
+func TestRangeEqual(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "[1,5]", b: "[1,6)", expected: true},
+		{a: "(0,5]", b: "[1,6)", expected: true},
+		{a: "[1,5]", b: "[1,5)", expected: false},
+		{a: "[5,5)", b: "empty", expected: true},
+		{a: "(5,6)", b: "[7,7)", expected: true},
+		{a: "[5,5]", b: "empty", expected: false},
+		{a: "(,5]", b: "(,6)", expected: true},
+		{a: "(,5]", b: "[0,6)", expected: false},
+	}
+
+	for i, tt := range tests {
+		var a, b pgtype.Int4range
+		require.NoError(t, a.Set(tt.a))
+		require.NoError(t, b.Set(tt.b))
+		require.Equalf(t, tt.expected, a.Equal(b), "%d. %s = %s", i, tt.a, tt.b)
+		require.Equalf(t, tt.expected, b.Equal(a), "%d. %s = %s", i, tt.b, tt.a)
+	}
+
+	require.True(t, mustNumrange(t, "[5,5)").Equal(mustNumrange(t, "empty")))
+	require.False(t, mustNumrange(t, "[1,5]").Equal(mustNumrange(t, "[1,5)")))
+	require.True(t, mustNumrange(t, "[1,5]").Equal(mustNumrange(t, "[1.0,5.00]")))
+
+	require.True(t, pgtype.Int4range{Status: pgtype.Null}.Equal(pgtype.Int4range{Status: pgtype.Null}))
+	require.False(t, pgtype.Int4range{Status: pgtype.Null}.Equal(pgtype.Int4range{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Status: pgtype.Present}))
+}
+
+func TestRangeIsEmpty(t *testing.T) {
+	for _, s := range []string{"empty", "[5,5)", "(5,6)"} {
+		var r pgtype.Int4range
+		require.NoError(t, r.Set(s))
+		require.Truef(t, r.IsEmpty(), "%s", s)
+	}
+
+	var r pgtype.Int4range
+	require.NoError(t, r.Set("[5,5]"))
+	require.False(t, r.IsEmpty())
+	require.True(t, mustNumrange(t, "(5,5]").IsEmpty())
+	require.False(t, mustNumrange(t, "(5,6)").IsEmpty())
+}
+
+func TestDiscreteRangeOperations(t *testing.T) {
+	var a, b pgtype.Int4range
+	require.NoError(t, a.Set("[1,2]"))
+	require.NoError(t, b.Set("[3,4]"))
+
+	require.True(t, a.Adjacent(b))
+	require.False(t, a.Overlaps(b))
+
+	union, err := a.Union(b)
+	require.NoError(t, err)
+	buf, err := union.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "[1,5)", string(buf))
+
+	require.NoError(t, b.Set("(2,3)"))
+	diff, err := a.Difference(b)
+	require.NoError(t, err)
+	buf, err = diff.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "[1,3)", string(buf))
+}
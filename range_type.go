@@ -0,0 +1,462 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgio"
+)
+
+// RangeType represents a range type. While it implements Value, this is only in service of its type conversion duties
+// when registered as a data type in a ConnType. It should not be used directly as a Value. RangeType is a convenience
+// type for range types that do not have a concrete range type such as user defined ranges.
+type RangeType struct {
+	lower     ValueTranscoder
+	upper     ValueTranscoder
+	lowerType BoundType
+	upperType BoundType
+	status    Status
+
+	typeName   string
+	elementOID uint32
+	newElement func() ValueTranscoder
+}
+
+// NewRangeType creates a RangeType for the range type typeName whose subtype has elementOID. newElement is used to
+// create the Values for the lower and upper bounds.
+func NewRangeType(typeName string, elementOID uint32, newElement func() ValueTranscoder) *RangeType {
+	return &RangeType{typeName: typeName, elementOID: elementOID, newElement: newElement}
+}
+
+func (rt *RangeType) NewTypeValue() Value {
+	a := &RangeType{
+		lowerType: rt.lowerType,
+		upperType: rt.upperType,
+
+		typeName:   rt.typeName,
+		elementOID: rt.elementOID,
+		newElement: rt.newElement,
+	}
+
+	if rt.lowerType == Inclusive || rt.lowerType == Exclusive {
+		a.lower = rt.newElement()
+	}
+	if rt.upperType == Inclusive || rt.upperType == Exclusive {
+		a.upper = rt.newElement()
+	}
+
+	return a
+}
+
+func (rt *RangeType) TypeName() string {
+	return rt.typeName
+}
+
+// ElementOID returns the OID of the range subtype.
+func (rt *RangeType) ElementOID() uint32 {
+	return rt.elementOID
+}
+
+// Lower returns the lower bound. It is nil if the range is NULL, empty, or has no lower bound.
+func (rt *RangeType) Lower() Value {
+	if rt.lower == nil {
+		return nil
+	}
+	return rt.lower
+}
+
+// Upper returns the upper bound. It is nil if the range is NULL, empty, or has no upper bound.
+func (rt *RangeType) Upper() Value {
+	if rt.upper == nil {
+		return nil
+	}
+	return rt.upper
+}
+
+func (rt *RangeType) LowerType() BoundType {
+	return rt.lowerType
+}
+
+func (rt *RangeType) UpperType() BoundType {
+	return rt.upperType
+}
+
+func (dst *RangeType) setNil() {
+	dst.lower = nil
+	dst.upper = nil
+	dst.lowerType = 0
+	dst.upperType = 0
+	dst.status = Null
+}
+
+func (dst *RangeType) Set(src interface{}) error {
+	// untyped nil and typed nil interfaces are different
+	if src == nil {
+		dst.setNil()
+		return nil
+	}
+
+	switch value := src.(type) {
+	case RangeType:
+		return dst.setRange(&value)
+	case *RangeType:
+		if value == nil {
+			dst.setNil()
+			return nil
+		}
+		return dst.setRange(value)
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			dst.setNil()
+			return nil
+		}
+		return dst.DecodeText(nil, []byte(*value))
+	case []byte:
+		if value == nil {
+			dst.setNil()
+			return nil
+		}
+		return dst.DecodeText(nil, value)
+	default:
+		gr, ok, err := rangeFromGoValue(src)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("cannot convert %v to range %s", src, dst.typeName)
+		}
+		return dst.setGenericRange(gr)
+	}
+}
+
+func (dst *RangeType) setGenericRange(gr *genericRange) error {
+	if gr == nil {
+		dst.setNil()
+		return nil
+	}
+
+	var lower, upper ValueTranscoder
+	if gr.LowerType == Inclusive || gr.LowerType == Exclusive {
+		lower = dst.newElement()
+		if err := lower.Set(gr.Lower); err != nil {
+			return err
+		}
+	}
+	if gr.UpperType == Inclusive || gr.UpperType == Exclusive {
+		upper = dst.newElement()
+		if err := upper.Set(gr.Upper); err != nil {
+			return err
+		}
+	}
+
+	dst.lower = lower
+	dst.upper = upper
+	dst.lowerType = gr.LowerType
+	dst.upperType = gr.UpperType
+	dst.status = Present
+
+	return nil
+}
+
+func (dst *RangeType) setRange(src *RangeType) error {
+	if src.status != Present {
+		dst.setNil()
+		dst.status = src.status
+		return nil
+	}
+
+	var lower, upper ValueTranscoder
+	if src.lower != nil {
+		lower = dst.newElement()
+		if err := lower.Set(src.lower.Get()); err != nil {
+			return err
+		}
+	}
+	if src.upper != nil {
+		upper = dst.newElement()
+		if err := upper.Set(src.upper.Get()); err != nil {
+			return err
+		}
+	}
+
+	dst.lower = lower
+	dst.upper = upper
+	dst.lowerType = src.lowerType
+	dst.upperType = src.upperType
+	dst.status = Present
+
+	return nil
+}
+
+func (dst RangeType) Get() interface{} {
+	switch dst.status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.status
+	}
+}
+
+func (src *RangeType) AssignTo(dst interface{}) error {
+	switch src.status {
+	case Present:
+		switch v := dst.(type) {
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		default:
+			if isRange, err := assignRangeToGoValue(src.lower, src.upper, src.lowerType, src.upperType, dst); isRange {
+				return err
+			}
+
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *RangeType) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		dst.setNil()
+		return nil
+	}
+
+	utr, err := ParseUntypedTextRange(string(src))
+	if err != nil {
+		return err
+	}
+
+	var lower, upper ValueTranscoder
+
+	if utr.LowerType == Inclusive || utr.LowerType == Exclusive {
+		lower = dst.newElement()
+		if err := lower.DecodeText(ci, []byte(utr.Lower)); err != nil {
+			return err
+		}
+	}
+
+	if utr.UpperType == Inclusive || utr.UpperType == Exclusive {
+		upper = dst.newElement()
+		if err := upper.DecodeText(ci, []byte(utr.Upper)); err != nil {
+			return err
+		}
+	}
+
+	dst.lower = lower
+	dst.upper = upper
+	dst.lowerType = utr.LowerType
+	dst.upperType = utr.UpperType
+	dst.status = Present
+
+	return nil
+}
+
+func (dst *RangeType) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		dst.setNil()
+		return nil
+	}
+
+	ubr, err := ParseUntypedBinaryRange(src)
+	if err != nil {
+		return err
+	}
+
+	var lower, upper ValueTranscoder
+
+	if ubr.LowerType == Inclusive || ubr.LowerType == Exclusive {
+		lower = dst.newElement()
+		if err := lower.DecodeBinary(ci, ubr.Lower); err != nil {
+			return err
+		}
+	}
+
+	if ubr.UpperType == Inclusive || ubr.UpperType == Exclusive {
+		upper = dst.newElement()
+		if err := upper.DecodeBinary(ci, ubr.Upper); err != nil {
+			return err
+		}
+	}
+
+	dst.lower = lower
+	dst.upper = upper
+	dst.lowerType = ubr.LowerType
+	dst.upperType = ubr.UpperType
+	dst.status = Present
+
+	return nil
+}
+
+func (src RangeType) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	switch src.lowerType {
+	case Exclusive, Unbounded:
+		buf = append(buf, '(')
+	case Inclusive:
+		buf = append(buf, '[')
+	case Empty:
+		return append(buf, "empty"...), nil
+	default:
+		return nil, fmt.Errorf("unknown lower bound type %v", src.lowerType)
+	}
+
+	if src.lowerType != Unbounded {
+		if src.lower == nil {
+			return nil, fmt.Errorf("Lower cannot be null unless LowerType is Unbounded")
+		}
+
+		elemBuf, err := src.lower.EncodeText(ci, nil)
+		if err != nil {
+			return nil, err
+		} else if elemBuf == nil {
+			return nil, fmt.Errorf("Lower cannot be null unless LowerType is Unbounded")
+		}
+		buf = append(buf, quoteRangeBoundIfNeeded(string(elemBuf))...)
+	}
+
+	buf = append(buf, ',')
+
+	if src.upperType != Unbounded {
+		if src.upper == nil {
+			return nil, fmt.Errorf("Upper cannot be null unless UpperType is Unbounded")
+		}
+
+		elemBuf, err := src.upper.EncodeText(ci, nil)
+		if err != nil {
+			return nil, err
+		} else if elemBuf == nil {
+			return nil, fmt.Errorf("Upper cannot be null unless UpperType is Unbounded")
+		}
+		buf = append(buf, quoteRangeBoundIfNeeded(string(elemBuf))...)
+	}
+
+	switch src.upperType {
+	case Exclusive, Unbounded:
+		buf = append(buf, ')')
+	case Inclusive:
+		buf = append(buf, ']')
+	default:
+		return nil, fmt.Errorf("unknown upper bound type %v", src.upperType)
+	}
+
+	return buf, nil
+}
+
+func (src RangeType) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	var rangeType byte
+	switch src.lowerType {
+	case Inclusive:
+		rangeType |= lowerInclusiveMask
+	case Unbounded:
+		rangeType |= lowerUnboundedMask
+	case Exclusive:
+	case Empty:
+		return append(buf, emptyMask), nil
+	default:
+		return nil, fmt.Errorf("unknown LowerType: %v", src.lowerType)
+	}
+
+	switch src.upperType {
+	case Inclusive:
+		rangeType |= upperInclusiveMask
+	case Unbounded:
+		rangeType |= upperUnboundedMask
+	case Exclusive:
+	default:
+		return nil, fmt.Errorf("unknown UpperType: %v", src.upperType)
+	}
+
+	buf = append(buf, rangeType)
+
+	var err error
+
+	if src.lowerType != Unbounded {
+		if src.lower == nil {
+			return nil, fmt.Errorf("Lower cannot be null unless LowerType is Unbounded")
+		}
+
+		sp := len(buf)
+		buf = pgio.AppendInt32(buf, -1)
+
+		buf, err = src.lower.EncodeBinary(ci, buf)
+		if err != nil {
+			return nil, err
+		}
+		if buf == nil {
+			return nil, fmt.Errorf("Lower cannot be null unless LowerType is Unbounded")
+		}
+
+		pgio.SetInt32(buf[sp:], int32(len(buf[sp:])-4))
+	}
+
+	if src.upperType != Unbounded {
+		if src.upper == nil {
+			return nil, fmt.Errorf("Upper cannot be null unless UpperType is Unbounded")
+		}
+
+		sp := len(buf)
+		buf = pgio.AppendInt32(buf, -1)
+
+		buf, err = src.upper.EncodeBinary(ci, buf)
+		if err != nil {
+			return nil, err
+		}
+		if buf == nil {
+			return nil, fmt.Errorf("Upper cannot be null unless UpperType is Unbounded")
+		}
+
+		pgio.SetInt32(buf[sp:], int32(len(buf[sp:])-4))
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *RangeType) Scan(src interface{}) error {
+	if src == nil {
+		return dst.DecodeText(nil, nil)
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src RangeType) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
@@ -0,0 +1,174 @@
+package pgtype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/matthewpi/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func newFloatrangeType() *pgtype.RangeType {
+	return pgtype.NewRangeType("floatrange", pgtype.Float8OID, func() pgtype.ValueTranscoder { return &pgtype.Float8{} })
+}
+
+func TestRangeTypeValue(t *testing.T) {
+	rangeType := newFloatrangeType()
+
+	err := rangeType.Set(nil)
+	require.NoError(t, err)
+	require.Nil(t, rangeType.Get())
+
+	err = rangeType.Set("[1.5,2.5)")
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Inclusive, rangeType.LowerType())
+	require.Equal(t, pgtype.Exclusive, rangeType.UpperType())
+	require.Equal(t, 1.5, rangeType.Lower().Get())
+	require.Equal(t, 2.5, rangeType.Upper().Get())
+
+	var s string
+	err = rangeType.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, "[1.5,2.5)", s)
+
+	err = rangeType.Set("(,3]")
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Unbounded, rangeType.LowerType())
+	require.Nil(t, rangeType.Lower())
+	require.Equal(t, 3.0, rangeType.Upper().Get())
+
+	err = rangeType.Set("empty")
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Empty, rangeType.LowerType())
+	require.Nil(t, rangeType.Lower())
+	require.Nil(t, rangeType.Upper())
+
+	err = rangeType.Set(nil)
+	require.NoError(t, err)
+	var ps *string
+	err = rangeType.AssignTo(&ps)
+	require.NoError(t, err)
+	require.Nil(t, ps)
+}
+
+func TestRangeTypeNewTypeValue(t *testing.T) {
+	rangeType := newFloatrangeType()
+	err := rangeType.Set("[1.5,2.5)")
+	require.NoError(t, err)
+
+	newValue := rangeType.NewTypeValue().(*pgtype.RangeType)
+	require.Equal(t, pgtype.Undefined, newValue.Get())
+	require.NotSame(t, rangeType.Lower(), newValue.Lower())
+	require.NotSame(t, rangeType.Upper(), newValue.Upper())
+
+	err = newValue.Lower().Set(3.5)
+	require.NoError(t, err)
+	require.Equal(t, 1.5, rangeType.Lower().Get())
+
+	err = newValue.Set("[4,5)")
+	require.NoError(t, err)
+	require.Equal(t, 1.5, rangeType.Lower().Get())
+	require.Equal(t, 2.5, rangeType.Upper().Get())
+}
+
+func TestRangeTypeEncodeDecode(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	for _, src := range []string{"empty", "[1.5,2.5)", "(-3,4]", "(,5)", "[6,)", "(,)"} {
+		rangeType := newFloatrangeType()
+		err := rangeType.Set(src)
+		require.NoError(t, err)
+
+		buf, err := rangeType.EncodeText(ci, nil)
+		require.NoError(t, err)
+		require.Equal(t, src, string(buf))
+
+		buf, err = rangeType.EncodeBinary(ci, nil)
+		require.NoError(t, err)
+
+		decoded := newFloatrangeType()
+		err = decoded.DecodeBinary(ci, buf)
+		require.NoError(t, err)
+
+		buf, err = decoded.EncodeText(ci, nil)
+		require.NoError(t, err)
+		require.Equal(t, src, string(buf))
+	}
+}
+
+func TestRangeTypeEncodeTextQuotesBounds(t *testing.T) {
+	rangeType := pgtype.NewRangeType("textrange", pgtype.TextOID, func() pgtype.ValueTranscoder { return &pgtype.Text{} })
+
+	err := rangeType.Set(`["a,b","c\"d")`)
+	require.NoError(t, err)
+	require.Equal(t, "a,b", rangeType.Lower().Get())
+	require.Equal(t, `c"d`, rangeType.Upper().Get())
+
+	buf, err := rangeType.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `["a,b","c\"d")`, string(buf))
+}
+
+func TestRangeTypeTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	_, err := conn.Exec(context.Background(), "drop type if exists pgtype_floatrange; create type pgtype_floatrange as range (subtype = float8);")
+	require.NoError(t, err)
+	defer conn.Exec(context.Background(), "drop type if exists pgtype_floatrange")
+
+	var oid uint32
+	err = conn.QueryRow(context.Background(), "select 'pgtype_floatrange'::regtype::oid").Scan(&oid)
+	require.NoError(t, err)
+
+	conn.ConnInfo().RegisterDataType(pgtype.DataType{
+		Value: pgtype.NewRangeType("pgtype_floatrange", pgtype.Float8OID, func() pgtype.ValueTranscoder { return &pgtype.Float8{} }),
+		Name:  "pgtype_floatrange",
+		OID:   oid,
+	})
+
+	for _, format := range []int16{pgx.TextFormatCode, pgx.BinaryFormatCode} {
+		var dst string
+		err := conn.QueryRow(context.Background(), "select $1::pgtype_floatrange", pgx.QueryResultFormats{format}, "[1.5,2.5)").Scan(&dst)
+		require.NoError(t, err)
+		require.Equal(t, "[1.5,2.5)", dst)
+	}
+}
+
+func TestRangeTypeSetAndAssignToStruct(t *testing.T) {
+	type floatRange struct {
+		Lower     float64
+		Upper     float64
+		LowerType pgtype.BoundType
+		UpperType pgtype.BoundType
+	}
+
+	rangeType := newFloatrangeType()
+
+	err := rangeType.Set(floatRange{Lower: 1.5, Upper: 2.5, LowerType: pgtype.Exclusive, UpperType: pgtype.Inclusive})
+	require.NoError(t, err)
+
+	buf, err := rangeType.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "(1.5,2.5]", string(buf))
+
+	var dst floatRange
+	err = rangeType.AssignTo(&dst)
+	require.NoError(t, err)
+	require.Equal(t, floatRange{Lower: 1.5, Upper: 2.5, LowerType: pgtype.Exclusive, UpperType: pgtype.Inclusive}, dst)
+
+	err = rangeType.Set([2]*float64{nil, nil})
+	require.NoError(t, err)
+
+	buf, err = rangeType.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "(,)", string(buf))
+
+	var bounds [2]*float64
+	err = rangeType.AssignTo(&bounds)
+	require.NoError(t, err)
+	require.Nil(t, bounds[0])
+	require.Nil(t, bounds[1])
+}
@@ -0,0 +1,164 @@
+package pgtype
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// RecordArray is the PostgreSQL record[] type. Like Record, RecordArray only implements BinaryDecoder and Value.
+// Elements that are NULL records have a Status of Null.
+type RecordArray struct {
+	Elements   []Record
+	Dimensions []ArrayDimension
+	Status     Status
+}
+
+func (dst *RecordArray) Set(src interface{}) error {
+	if src == nil {
+		*dst = RecordArray{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case RecordArray:
+		*dst = value
+	case *RecordArray:
+		if value == nil {
+			*dst = RecordArray{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case []Record:
+		if value == nil {
+			*dst = RecordArray{Status: Null}
+		} else if len(value) == 0 {
+			*dst = RecordArray{Status: Present}
+		} else {
+			*dst = RecordArray{
+				Elements:   value,
+				Dimensions: []ArrayDimension{{Length: int32(len(value)), LowerBound: 1}},
+				Status:     Present,
+			}
+		}
+	case [][]Value:
+		if value == nil {
+			*dst = RecordArray{Status: Null}
+		} else if len(value) == 0 {
+			*dst = RecordArray{Status: Present}
+		} else {
+			elements := make([]Record, len(value))
+			for i := range value {
+				if err := elements[i].Set(value[i]); err != nil {
+					return err
+				}
+			}
+			*dst = RecordArray{
+				Elements:   elements,
+				Dimensions: []ArrayDimension{{Length: int32(len(elements)), LowerBound: 1}},
+				Status:     Present,
+			}
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to RecordArray", src)
+	}
+
+	return nil
+}
+
+func (dst RecordArray) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *RecordArray) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		if len(src.Dimensions) <= 1 {
+			switch v := dst.(type) {
+			case *[]Record:
+				*v = make([]Record, len(src.Elements))
+				copy(*v, src.Elements)
+				return nil
+			case *[][]Value:
+				*v = make([][]Value, len(src.Elements))
+				for i := range src.Elements {
+					if err := src.Elements[i].AssignTo(&((*v)[i])); err != nil {
+						return err
+					}
+				}
+				return nil
+			case *[][]interface{}:
+				*v = make([][]interface{}, len(src.Elements))
+				for i := range src.Elements {
+					if err := src.Elements[i].AssignTo(&((*v)[i])); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+
+		if nextDst, retry := GetAssignToDstType(dst); retry {
+			return src.AssignTo(nextDst)
+		}
+		return fmt.Errorf("unable to assign to %T", dst)
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *RecordArray) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = RecordArray{Status: Null}
+		return nil
+	}
+
+	var arrayHeader ArrayHeader
+	rp, err := arrayHeader.DecodeBinary(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if len(arrayHeader.Dimensions) == 0 {
+		*dst = RecordArray{Dimensions: arrayHeader.Dimensions, Status: Present}
+		return nil
+	}
+
+	elementCount := arrayHeader.Dimensions[0].Length
+	for _, d := range arrayHeader.Dimensions[1:] {
+		elementCount *= d.Length
+	}
+
+	elements := make([]Record, elementCount)
+
+	for i := range elements {
+		if len(src[rp:]) < 4 {
+			return fmt.Errorf("invalid record array data")
+		}
+		elemLen := int(int32(binary.BigEndian.Uint32(src[rp:])))
+		rp += 4
+		var elemSrc []byte
+		if elemLen >= 0 {
+			if len(src[rp:]) < elemLen {
+				return fmt.Errorf("invalid record array data")
+			}
+			elemSrc = src[rp : rp+elemLen]
+			rp += elemLen
+		}
+		err = elements[i].DecodeBinary(ci, elemSrc)
+		if err != nil {
+			return err
+		}
+	}
+
+	*dst = RecordArray{Elements: elements, Dimensions: arrayHeader.Dimensions, Status: Present}
+	return nil
+}
@@ -0,0 +1,60 @@
+package pgtype_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/matthewpi/pgx/v4"
+)
+
+func TestRecordArrayTranscode(t *testing.T) {
+	conn := testutil.MustConnectPgx(t)
+	defer testutil.MustCloseContext(t, conn)
+
+	tests := []struct {
+		sql      string
+		expected pgtype.RecordArray
+	}{
+		{
+			sql:      `select '{}'::record[]`,
+			expected: pgtype.RecordArray{Status: pgtype.Present},
+		},
+		{
+			sql: `select array[row('foo'::text, 42::int4), null]`,
+			expected: pgtype.RecordArray{
+				Elements: []pgtype.Record{
+					{
+						Fields: []pgtype.Value{
+							&pgtype.Text{String: "foo", Status: pgtype.Present},
+							&pgtype.Int4{Int: 42, Status: pgtype.Present},
+						},
+						Status: pgtype.Present,
+					},
+					{Status: pgtype.Null},
+				},
+				Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+				Status:     pgtype.Present,
+			},
+		},
+		{
+			sql:      `select null::record[]`,
+			expected: pgtype.RecordArray{Status: pgtype.Null},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			var result pgtype.RecordArray
+			if err := conn.QueryRow(context.Background(), tt.sql, pgx.QueryResultFormats{pgx.BinaryFormatCode}).Scan(&result); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("expected %#v, got %#v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// regOID is the core type that is used to implement the PostgreSQL object identifier alias types such as regclass and
+// regtype.
+//
+// In the binary format these types are an OID. In the text format they are the name of the object, possibly schema
+// qualified and quoted, such as public."MyTable". An OID that does not match an object is formatted as a number and
+// InvalidOid (0) as "-". Depending on the format a decoded value may only have one of OID and Name.
+type regOID struct {
+	OID    uint32
+	Name   string
+	Status Status
+}
+
+func (dst *regOID) Set(src interface{}) error {
+	if src == nil {
+		*dst = regOID{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case uint32:
+		*dst = regOID{OID: value, Status: Present}
+	case *uint32:
+		if value == nil {
+			*dst = regOID{Status: Null}
+		} else {
+			*dst = regOID{OID: *value, Status: Present}
+		}
+	case OID:
+		*dst = regOID{OID: uint32(value), Status: Present}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = regOID{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to OID alias type", value)
+	}
+
+	return nil
+}
+
+// AssignTo assigns from src to dst. Assigning to a uint32 requires that the OID is known. Assigning to a string gives
+// the name if it is known and otherwise the OID in the PostgreSQL text format.
+func (src *regOID) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *uint32:
+			if src.OID == 0 && src.Name != "" {
+				return fmt.Errorf("cannot assign %q to %T: OID is unknown", src.Name, dst)
+			}
+			*v = src.OID
+			return nil
+		case *OID:
+			if src.OID == 0 && src.Name != "" {
+				return fmt.Errorf("cannot assign %q to %T: OID is unknown", src.Name, dst)
+			}
+			*v = OID(src.OID)
+			return nil
+		case *string:
+			*v = string(src.appendText(nil))
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *regOID) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = regOID{Status: Null}
+		return nil
+	}
+
+	s := string(src)
+	if s == "-" {
+		*dst = regOID{Status: Present}
+		return nil
+	}
+
+	if s == "" {
+		return fmt.Errorf("invalid object name: %q", s)
+	}
+
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+		*dst = regOID{OID: uint32(n), Status: Present}
+		return nil
+	}
+
+	*dst = regOID{Name: s, Status: Present}
+	return nil
+}
+
+func (dst *regOID) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = regOID{Status: Null}
+		return nil
+	}
+
+	if len(src) != 4 {
+		return fmt.Errorf("invalid length: %v", len(src))
+	}
+
+	*dst = regOID{OID: binary.BigEndian.Uint32(src), Status: Present}
+	return nil
+}
+
+func (src regOID) appendText(buf []byte) []byte {
+	switch {
+	case src.Name != "":
+		return append(buf, src.Name...)
+	case src.OID == 0:
+		return append(buf, '-')
+	default:
+		return strconv.AppendUint(buf, uint64(src.OID), 10)
+	}
+}
+
+func (src regOID) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return src.appendText(buf), nil
+}
+
+// EncodeBinary encodes the OID of src. It is an error if src only has a name.
+func (src regOID) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	if src.OID == 0 && src.Name != "" {
+		return nil, fmt.Errorf("cannot encode %q in binary format: OID is unknown", src.Name)
+	}
+
+	return pgio.AppendUint32(buf, src.OID), nil
+}
+
+// PreferredParamFormat returns the text format when only the name is known so that the server resolves the name.
+func (src regOID) PreferredParamFormat() int16 {
+	if src.OID == 0 && src.Name != "" {
+		return TextFormatCode
+	}
+	return BinaryFormatCode
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *regOID) Scan(src interface{}) error {
+	if src == nil {
+		*dst = regOID{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case int64:
+		*dst = regOID{OID: uint32(src), Status: Present}
+		return nil
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src regOID) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
+
+// regtypeNameAliases maps the SQL standard type names used by the regtype output function to the PostgreSQL internal
+// names that data types are registered under.
+var regtypeNameAliases = map[string]string{
+	"bigint":                      "int8",
+	"bit varying":                 "varbit",
+	"boolean":                     "bool",
+	"character":                   "bpchar",
+	"character varying":           "varchar",
+	"double precision":            "float8",
+	"integer":                     "int4",
+	"real":                        "float4",
+	"smallint":                    "int2",
+	"time with time zone":         "timetz",
+	"time without time zone":      "time",
+	"timestamp with time zone":    "timestamptz",
+	"timestamp without time zone": "timestamp",
+}
+
+// lookupTypeNameOID finds the OID of the data type registered in ci for the regtype text format name. Besides the
+// registered names it understands the SQL standard names such as integer, quoted and schema qualified names, and the
+// [] suffix of array types.
+func lookupTypeNameOID(ci *ConnInfo, name string) (uint32, bool) {
+	if ci == nil {
+		return 0, false
+	}
+
+	if dt, ok := ci.DataTypeForName(name); ok {
+		return dt.OID, true
+	}
+
+	isArray := false
+	if strings.HasSuffix(name, "[]") {
+		isArray = true
+		name = name[:len(name)-2]
+	}
+
+	parts, err := parseQualifiedName(name)
+	if err != nil {
+		return 0, false
+	}
+
+	// Types in pg_catalog are registered under their unqualified names.
+	if len(parts) == 2 && parts[0] == "pg_catalog" {
+		parts = parts[1:]
+	}
+
+	name = strings.Join(parts, ".")
+	if alias, ok := regtypeNameAliases[name]; ok {
+		name = alias
+	}
+	if isArray {
+		name = "_" + name
+	}
+
+	if dt, ok := ci.DataTypeForName(name); ok {
+		return dt.OID, true
+	}
+
+	return 0, false
+}
+
+// parseQualifiedName splits a possibly schema qualified name into its identifiers and removes any quoting.
+func parseQualifiedName(s string) ([]string, error) {
+	var parts []string
+	var sb strings.Builder
+
+	for i := 0; i < len(s); {
+		if s[i] == '"' {
+			i++
+			for {
+				if i >= len(s) {
+					return nil, fmt.Errorf("unterminated quoted identifier: %q", s)
+				}
+				if s[i] == '"' {
+					if i+1 < len(s) && s[i+1] == '"' {
+						sb.WriteByte('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteByte(s[i])
+				i++
+			}
+		} else {
+			for i < len(s) && s[i] != '.' && s[i] != '"' {
+				sb.WriteByte(s[i])
+				i++
+			}
+		}
+
+		if i < len(s) {
+			if s[i] != '.' {
+				return nil, fmt.Errorf("invalid name syntax: %q", s)
+			}
+			i++
+			if i == len(s) {
+				return nil, fmt.Errorf("invalid name syntax: %q", s)
+			}
+		}
+
+		if sb.Len() == 0 {
+			return nil, fmt.Errorf("invalid name syntax: %q", s)
+		}
+		parts = append(parts, sb.String())
+		sb.Reset()
+	}
+
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("invalid name syntax: %q", s)
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,71 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+)
+
+// Regclass represents the PostgreSQL regclass type, an OID alias type for relations.
+// The binary format is the OID and the text format is the name.
+type Regclass regOID
+
+func (dst *Regclass) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Regclass:
+		*dst = value
+		return nil
+	case *Regclass:
+		if value == nil {
+			*dst = Regclass{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	return (*regOID)(dst).Set(src)
+}
+
+func (dst Regclass) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Regclass) AssignTo(dst interface{}) error {
+	return (*regOID)(src).AssignTo(dst)
+}
+
+func (dst *Regclass) DecodeText(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeText(ci, src)
+}
+
+func (dst *Regclass) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeBinary(ci, src)
+}
+
+func (src Regclass) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeText(ci, buf)
+}
+
+func (src Regclass) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeBinary(ci, buf)
+}
+
+func (src Regclass) PreferredParamFormat() int16 {
+	return (regOID)(src).PreferredParamFormat()
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Regclass) Scan(src interface{}) error {
+	return (*regOID)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Regclass) Value() (driver.Value, error) {
+	return (regOID)(src).Value()
+}
@@ -0,0 +1,42 @@
+package pgtype_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestRegclassArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "regclass[]", []interface{}{
+		&pgtype.RegclassArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.RegclassArray{
+			Elements: []pgtype.Regclass{
+				{OID: 1259, Name: "pg_class", Status: pgtype.Present},
+				{OID: 0, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.RegclassArray{Status: pgtype.Null},
+	}, func(ai, bi interface{}) bool {
+		a := ai.(pgtype.RegclassArray)
+		b := bi.(pgtype.RegclassArray)
+
+		if a.Status != b.Status || !reflect.DeepEqual(a.Dimensions, b.Dimensions) || len(a.Elements) != len(b.Elements) {
+			return false
+		}
+		for i := range a.Elements {
+			if !regclassEqual(a.Elements[i], b.Elements[i]) {
+				return false
+			}
+		}
+		return true
+	})
+}
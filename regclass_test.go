@@ -0,0 +1,94 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegclassTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "regclass", []interface{}{
+		&pgtype.Regclass{OID: 1259, Name: "pg_class", Status: pgtype.Present},
+		&pgtype.Regclass{Status: pgtype.Present},
+		&pgtype.Regclass{Status: pgtype.Null},
+	}, func(a, b interface{}) bool {
+		return regclassEqual(a.(pgtype.Regclass), b.(pgtype.Regclass))
+	})
+}
+
+// regclassEqual reports whether the decoded Regclass a matches the expected Regclass b. The binary format only returns
+// the OID and the text format only returns the name, so only the fields that were decoded are compared.
+func regclassEqual(a, b pgtype.Regclass) bool {
+	if a.OID == 0 && a.Name == "" && (b.OID != 0 || b.Name != "") {
+		return false
+	}
+	return a.Status == b.Status && (a.OID == 0 || a.OID == b.OID) && (a.Name == "" || a.Name == b.Name)
+}
+
+func TestRegclassDecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result pgtype.Regclass
+	}{
+		{source: "pg_class", result: pgtype.Regclass{Name: "pg_class", Status: pgtype.Present}},
+		{source: `public."MyTable"`, result: pgtype.Regclass{Name: `public."MyTable"`, Status: pgtype.Present}},
+		{source: "1259", result: pgtype.Regclass{OID: 1259, Status: pgtype.Present}},
+		{source: "-", result: pgtype.Regclass{Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Regclass
+		err := r.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, tt.result, r, "%d: %s", i, tt.source)
+	}
+}
+
+func TestRegclassEncode(t *testing.T) {
+	buf, err := pgtype.Regclass{Name: "pg_class", OID: 1259, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "pg_class", string(buf))
+
+	buf, err = pgtype.Regclass{OID: 1259, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "1259", string(buf))
+
+	buf, err = pgtype.Regclass{Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "-", string(buf))
+
+	buf, err = pgtype.Regclass{OID: 1259, Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0x04, 0xeb}, buf)
+
+	_, err = pgtype.Regclass{Name: "pg_class", Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.Error(t, err)
+}
+
+func TestRegclassPreferredParamFormat(t *testing.T) {
+	require.EqualValues(t, pgtype.BinaryFormatCode, pgtype.Regclass{OID: 1259, Status: pgtype.Present}.PreferredParamFormat())
+	require.EqualValues(t, pgtype.TextFormatCode, pgtype.Regclass{Name: "pg_class", Status: pgtype.Present}.PreferredParamFormat())
+}
+
+func TestRegclassAssignTo(t *testing.T) {
+	var oid uint32
+	var s string
+
+	src := pgtype.Regclass{OID: 1259, Status: pgtype.Present}
+	require.NoError(t, src.AssignTo(&oid))
+	require.Equal(t, uint32(1259), oid)
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, "1259", s)
+
+	src = pgtype.Regclass{Name: "pg_class", Status: pgtype.Present}
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, "pg_class", s)
+	require.Error(t, src.AssignTo(&oid))
+
+	var ps *string
+	src = pgtype.Regclass{Status: pgtype.Null}
+	require.NoError(t, src.AssignTo(&ps))
+	require.Nil(t, ps)
+}
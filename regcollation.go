@@ -0,0 +1,71 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+)
+
+// Regcollation represents the PostgreSQL regcollation type, an OID alias type for collations.
+// The binary format is the OID and the text format is the name.
+type Regcollation regOID
+
+func (dst *Regcollation) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Regcollation:
+		*dst = value
+		return nil
+	case *Regcollation:
+		if value == nil {
+			*dst = Regcollation{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	return (*regOID)(dst).Set(src)
+}
+
+func (dst Regcollation) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Regcollation) AssignTo(dst interface{}) error {
+	return (*regOID)(src).AssignTo(dst)
+}
+
+func (dst *Regcollation) DecodeText(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeText(ci, src)
+}
+
+func (dst *Regcollation) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeBinary(ci, src)
+}
+
+func (src Regcollation) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeText(ci, buf)
+}
+
+func (src Regcollation) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeBinary(ci, buf)
+}
+
+func (src Regcollation) PreferredParamFormat() int16 {
+	return (regOID)(src).PreferredParamFormat()
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Regcollation) Scan(src interface{}) error {
+	return (*regOID)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Regcollation) Value() (driver.Value, error) {
+	return (regOID)(src).Value()
+}
@@ -0,0 +1,71 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+)
+
+// Regconfig represents the PostgreSQL regconfig type, an OID alias type for text search configurations.
+// The binary format is the OID and the text format is the name.
+type Regconfig regOID
+
+func (dst *Regconfig) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Regconfig:
+		*dst = value
+		return nil
+	case *Regconfig:
+		if value == nil {
+			*dst = Regconfig{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	return (*regOID)(dst).Set(src)
+}
+
+func (dst Regconfig) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Regconfig) AssignTo(dst interface{}) error {
+	return (*regOID)(src).AssignTo(dst)
+}
+
+func (dst *Regconfig) DecodeText(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeText(ci, src)
+}
+
+func (dst *Regconfig) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeBinary(ci, src)
+}
+
+func (src Regconfig) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeText(ci, buf)
+}
+
+func (src Regconfig) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeBinary(ci, buf)
+}
+
+func (src Regconfig) PreferredParamFormat() int16 {
+	return (regOID)(src).PreferredParamFormat()
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Regconfig) Scan(src interface{}) error {
+	return (*regOID)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Regconfig) Value() (driver.Value, error) {
+	return (regOID)(src).Value()
+}
@@ -0,0 +1,71 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+)
+
+// Regdictionary represents the PostgreSQL regdictionary type, an OID alias type for text search dictionaries.
+// The binary format is the OID and the text format is the name.
+type Regdictionary regOID
+
+func (dst *Regdictionary) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Regdictionary:
+		*dst = value
+		return nil
+	case *Regdictionary:
+		if value == nil {
+			*dst = Regdictionary{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	return (*regOID)(dst).Set(src)
+}
+
+func (dst Regdictionary) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Regdictionary) AssignTo(dst interface{}) error {
+	return (*regOID)(src).AssignTo(dst)
+}
+
+func (dst *Regdictionary) DecodeText(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeText(ci, src)
+}
+
+func (dst *Regdictionary) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeBinary(ci, src)
+}
+
+func (src Regdictionary) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeText(ci, buf)
+}
+
+func (src Regdictionary) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeBinary(ci, buf)
+}
+
+func (src Regdictionary) PreferredParamFormat() int16 {
+	return (regOID)(src).PreferredParamFormat()
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Regdictionary) Scan(src interface{}) error {
+	return (*regOID)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Regdictionary) Value() (driver.Value, error) {
+	return (regOID)(src).Value()
+}
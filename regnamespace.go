@@ -0,0 +1,71 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+)
+
+// Regnamespace represents the PostgreSQL regnamespace type, an OID alias type for schemas.
+// The binary format is the OID and the text format is the name.
+type Regnamespace regOID
+
+func (dst *Regnamespace) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Regnamespace:
+		*dst = value
+		return nil
+	case *Regnamespace:
+		if value == nil {
+			*dst = Regnamespace{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	return (*regOID)(dst).Set(src)
+}
+
+func (dst Regnamespace) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Regnamespace) AssignTo(dst interface{}) error {
+	return (*regOID)(src).AssignTo(dst)
+}
+
+func (dst *Regnamespace) DecodeText(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeText(ci, src)
+}
+
+func (dst *Regnamespace) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeBinary(ci, src)
+}
+
+func (src Regnamespace) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeText(ci, buf)
+}
+
+func (src Regnamespace) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeBinary(ci, buf)
+}
+
+func (src Regnamespace) PreferredParamFormat() int16 {
+	return (regOID)(src).PreferredParamFormat()
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Regnamespace) Scan(src interface{}) error {
+	return (*regOID)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Regnamespace) Value() (driver.Value, error) {
+	return (regOID)(src).Value()
+}
@@ -0,0 +1,98 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+)
+
+// Regtype represents the PostgreSQL regtype type, an OID alias type for data types.
+// The binary format is the OID and the text format is the name.
+type Regtype regOID
+
+func (dst *Regtype) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Regtype:
+		*dst = value
+		return nil
+	case *Regtype:
+		if value == nil {
+			*dst = Regtype{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	return (*regOID)(dst).Set(src)
+}
+
+func (dst Regtype) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Regtype) AssignTo(dst interface{}) error {
+	return (*regOID)(src).AssignTo(dst)
+}
+
+// ResolveOID returns the OID of src. If the OID is unknown it looks up the name in the data types registered in ci.
+func (src Regtype) ResolveOID(ci *ConnInfo) (uint32, bool) {
+	if src.Status != Present {
+		return 0, false
+	}
+	if src.OID != 0 || src.Name == "" {
+		return src.OID, true
+	}
+	return lookupTypeNameOID(ci, src.Name)
+}
+
+// DecodeText decodes from src into dst. If the name is the name of a data type registered in ci the OID is set as well.
+func (dst *Regtype) DecodeText(ci *ConnInfo, src []byte) error {
+	err := (*regOID)(dst).DecodeText(ci, src)
+	if err != nil {
+		return err
+	}
+
+	if dst.Status == Present && dst.Name != "" {
+		if oid, ok := lookupTypeNameOID(ci, dst.Name); ok {
+			dst.OID = oid
+		}
+	}
+
+	return nil
+}
+
+func (dst *Regtype) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return (*regOID)(dst).DecodeBinary(ci, src)
+}
+
+func (src Regtype) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return (regOID)(src).EncodeText(ci, buf)
+}
+
+// EncodeBinary encodes the OID of src. If only the name is known the OID is found with ResolveOID.
+func (src Regtype) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	if oid, ok := src.ResolveOID(ci); ok {
+		src.OID = oid
+	}
+	return (regOID)(src).EncodeBinary(ci, buf)
+}
+
+func (src Regtype) PreferredParamFormat() int16 {
+	return (regOID)(src).PreferredParamFormat()
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Regtype) Scan(src interface{}) error {
+	return (*regOID)(dst).Scan(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Regtype) Value() (driver.Value, error) {
+	return (regOID)(src).Value()
+}
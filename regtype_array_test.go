@@ -0,0 +1,42 @@
+package pgtype_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestRegtypeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "regtype[]", []interface{}{
+		&pgtype.RegtypeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.RegtypeArray{
+			Elements: []pgtype.Regtype{
+				{OID: 23, Name: "integer", Status: pgtype.Present},
+				{OID: 1007, Name: "integer[]", Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.RegtypeArray{Status: pgtype.Null},
+	}, func(ai, bi interface{}) bool {
+		a := ai.(pgtype.RegtypeArray)
+		b := bi.(pgtype.RegtypeArray)
+
+		if a.Status != b.Status || !reflect.DeepEqual(a.Dimensions, b.Dimensions) || len(a.Elements) != len(b.Elements) {
+			return false
+		}
+		for i := range a.Elements {
+			if !regtypeEqual(a.Elements[i], b.Elements[i]) {
+				return false
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,79 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegtypeTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "regtype", []interface{}{
+		&pgtype.Regtype{OID: pgtype.Int4OID, Name: "integer", Status: pgtype.Present},
+		&pgtype.Regtype{Status: pgtype.Null},
+	}, func(a, b interface{}) bool {
+		return regtypeEqual(a.(pgtype.Regtype), b.(pgtype.Regtype))
+	})
+}
+
+// regtypeEqual reports whether the decoded Regtype a matches the expected Regtype b. The binary format only returns the
+// OID and the text format returns the name, which is only resolved to an OID when a ConnInfo is available, so only the
+// fields that were decoded are compared.
+func regtypeEqual(a, b pgtype.Regtype) bool {
+	if a.OID == 0 && a.Name == "" && (b.OID != 0 || b.Name != "") {
+		return false
+	}
+	return a.Status == b.Status && (a.OID == 0 || a.OID == b.OID) && (a.Name == "" || a.Name == b.Name)
+}
+
+func TestRegtypeResolveOID(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	tests := []struct {
+		name string
+		oid  uint32
+	}{
+		{name: "int4", oid: pgtype.Int4OID},
+		{name: "integer", oid: pgtype.Int4OID},
+		{name: "integer[]", oid: pgtype.Int4ArrayOID},
+		{name: "pg_catalog.int8", oid: pgtype.Int8OID},
+		{name: "timestamp with time zone", oid: pgtype.TimestamptzOID},
+		{name: "character varying[]", oid: pgtype.VarcharArrayOID},
+		{name: `"char"`, oid: pgtype.QCharOID},
+		{name: `pg_catalog."text"`, oid: pgtype.TextOID},
+	}
+
+	for i, tt := range tests {
+		oid, ok := pgtype.Regtype{Name: tt.name, Status: pgtype.Present}.ResolveOID(ci)
+		require.Truef(t, ok, "%d: %s", i, tt.name)
+		require.Equalf(t, tt.oid, oid, "%d: %s", i, tt.name)
+	}
+
+	for i, name := range []string{"unknown_type", "public.int4", `"unterminated`, "a..b"} {
+		_, ok := pgtype.Regtype{Name: name, Status: pgtype.Present}.ResolveOID(ci)
+		require.Falsef(t, ok, "%d: %s", i, name)
+	}
+}
+
+func TestRegtypeDecodeTextResolvesOID(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	var r pgtype.Regtype
+	require.NoError(t, r.DecodeText(ci, []byte("bigint")))
+	require.Equal(t, pgtype.Regtype{OID: pgtype.Int8OID, Name: "bigint", Status: pgtype.Present}, r)
+
+	require.NoError(t, r.DecodeText(ci, []byte("mytype")))
+	require.Equal(t, pgtype.Regtype{Name: "mytype", Status: pgtype.Present}, r)
+}
+
+func TestRegtypeEncodeBinaryResolvesOID(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	buf, err := pgtype.Regtype{Name: "text", Status: pgtype.Present}.EncodeBinary(ci, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 25}, buf)
+
+	_, err = pgtype.Regtype{Name: "mytype", Status: pgtype.Present}.EncodeBinary(ci, nil)
+	require.Error(t, err)
+}
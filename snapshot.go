@@ -0,0 +1,282 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// Snapshot represents a PostgreSQL pg_snapshot value. It is also used for the legacy txid_snapshot type which has the
+// same text and binary formats.
+//
+// Xmin is the earliest transaction ID that was still active. Xmax is the first as yet unassigned transaction ID. Xip
+// holds the transaction IDs that were in progress at the time of the snapshot in ascending order. All IDs are 64-bit
+// transaction IDs as with XID8.
+type Snapshot struct {
+	Xmin   uint64
+	Xmax   uint64
+	Xip    []uint64
+	Status Status
+}
+
+func (dst *Snapshot) Set(src interface{}) error {
+	if src == nil {
+		*dst = Snapshot{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Snapshot:
+		*dst = value
+	case *Snapshot:
+		if value == nil {
+			*dst = Snapshot{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = Snapshot{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to Snapshot", value)
+	}
+
+	return nil
+}
+
+func (dst Snapshot) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *Snapshot) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+// IsVisible reports whether the changes of transaction xid are visible in the snapshot. It is equivalent to the
+// PostgreSQL pg_visible_in_snapshot function. A transaction is visible if it committed or aborted before the snapshot
+// was taken, that is if it is before Xmin or it is before Xmax and not in Xip. IsVisible returns false if src is not
+// present.
+func (src Snapshot) IsVisible(xid uint64) bool {
+	if src.Status != Present {
+		return false
+	}
+
+	if xid < src.Xmin {
+		return true
+	}
+	if xid >= src.Xmax {
+		return false
+	}
+	for _, x := range src.Xip {
+		if x == xid {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (dst *Snapshot) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Snapshot{Status: Null}
+		return nil
+	}
+
+	s := string(src)
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid input syntax for type pg_snapshot: %q", s)
+	}
+
+	xmin, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid input syntax for type pg_snapshot: %q", s)
+	}
+	xmax, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid input syntax for type pg_snapshot: %q", s)
+	}
+
+	var xip []uint64
+	if parts[2] != "" {
+		for _, p := range strings.Split(parts[2], ",") {
+			x, err := strconv.ParseUint(p, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid input syntax for type pg_snapshot: %q", s)
+			}
+			xip = append(xip, x)
+		}
+	}
+
+	snapshot, err := newSnapshot(xmin, xmax, xip)
+	if err != nil {
+		return fmt.Errorf("invalid input syntax for type pg_snapshot: %q", s)
+	}
+
+	*dst = snapshot
+	return nil
+}
+
+// newSnapshot validates a snapshot the same way as the PostgreSQL pg_snapshot input functions. Xmin and xmax must be
+// valid transaction IDs with xmin <= xmax, and xip must be in ascending order and within [xmin, xmax). Duplicate xip
+// values are removed.
+func newSnapshot(xmin, xmax uint64, xip []uint64) (Snapshot, error) {
+	if xmin == 0 || xmax == 0 || xmax < xmin {
+		return Snapshot{}, fmt.Errorf("invalid snapshot bounds %d:%d", xmin, xmax)
+	}
+
+	var result []uint64
+	for i, x := range xip {
+		if x < xmin || x >= xmax {
+			return Snapshot{}, fmt.Errorf("snapshot xip %d out of range %d:%d", x, xmin, xmax)
+		}
+		if i > 0 {
+			if x < xip[i-1] {
+				return Snapshot{}, fmt.Errorf("snapshot xip is not in ascending order")
+			}
+			if x == xip[i-1] {
+				continue
+			}
+		}
+		result = append(result, x)
+	}
+
+	return Snapshot{Xmin: xmin, Xmax: xmax, Xip: result, Status: Present}, nil
+}
+
+func (dst *Snapshot) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Snapshot{Status: Null}
+		return nil
+	}
+
+	if len(src) < 20 {
+		return fmt.Errorf("invalid length for pg_snapshot: %v", len(src))
+	}
+
+	nxip := int(int32(binary.BigEndian.Uint32(src)))
+	if nxip < 0 || len(src) != 20+nxip*8 {
+		return fmt.Errorf("invalid length for pg_snapshot: %v", len(src))
+	}
+
+	xmin := binary.BigEndian.Uint64(src[4:])
+	xmax := binary.BigEndian.Uint64(src[12:])
+
+	var xip []uint64
+	if nxip > 0 {
+		xip = make([]uint64, nxip)
+		rp := 20
+		for i := range xip {
+			xip[i] = binary.BigEndian.Uint64(src[rp:])
+			rp += 8
+		}
+	}
+
+	snapshot, err := newSnapshot(xmin, xmax, xip)
+	if err != nil {
+		return err
+	}
+
+	*dst = snapshot
+	return nil
+}
+
+func (src Snapshot) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = strconv.AppendUint(buf, src.Xmin, 10)
+	buf = append(buf, ':')
+	buf = strconv.AppendUint(buf, src.Xmax, 10)
+	buf = append(buf, ':')
+	for i, x := range src.Xip {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendUint(buf, x, 10)
+	}
+
+	return buf, nil
+}
+
+func (src Snapshot) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = pgio.AppendInt32(buf, int32(len(src.Xip)))
+	buf = pgio.AppendUint64(buf, src.Xmin)
+	buf = pgio.AppendUint64(buf, src.Xmax)
+	for _, x := range src.Xip {
+		buf = pgio.AppendUint64(buf, x)
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Snapshot) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Snapshot{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Snapshot) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
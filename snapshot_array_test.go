@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestSnapshotArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "pg_snapshot[]", []interface{}{
+		&pgtype.SnapshotArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.SnapshotArray{
+			Elements: []pgtype.Snapshot{
+				{Xmin: 10, Xmax: 20, Status: pgtype.Present},
+				{Xmin: 10, Xmax: 20, Xip: []uint64{10, 15}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.SnapshotArray{Status: pgtype.Null},
+	})
+}
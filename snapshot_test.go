@@ -0,0 +1,108 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotTranscode(t *testing.T) {
+	values := []interface{}{
+		&pgtype.Snapshot{Xmin: 10, Xmax: 10, Status: pgtype.Present},
+		&pgtype.Snapshot{Xmin: 10, Xmax: 20, Xip: []uint64{10, 14, 15}, Status: pgtype.Present},
+		&pgtype.Snapshot{Status: pgtype.Null},
+	}
+
+	testutil.TestSuccessfulTranscode(t, "pg_snapshot", values)
+	testutil.TestSuccessfulTranscode(t, "txid_snapshot", values)
+}
+
+func TestSnapshotDecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result pgtype.Snapshot
+	}{
+		{source: "10:20:", result: pgtype.Snapshot{Xmin: 10, Xmax: 20, Status: pgtype.Present}},
+		{source: "10:20:10,14,15", result: pgtype.Snapshot{Xmin: 10, Xmax: 20, Xip: []uint64{10, 14, 15}, Status: pgtype.Present}},
+		{source: "10:20:14,14,15", result: pgtype.Snapshot{Xmin: 10, Xmax: 20, Xip: []uint64{14, 15}, Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Snapshot
+		err := r.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, tt.result, r, "%d: %s", i, tt.source)
+	}
+
+	for i, source := range []string{"", "10:20", "0:20:", "20:10:", "10:20:15,14", "10:20:9", "10:20:20", "10:20:14,", "a:20:"} {
+		var r pgtype.Snapshot
+		err := r.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestSnapshotEncodeText(t *testing.T) {
+	buf, err := pgtype.Snapshot{Xmin: 10, Xmax: 20, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "10:20:", string(buf))
+
+	buf, err = pgtype.Snapshot{Xmin: 10, Xmax: 20, Xip: []uint64{10, 14, 15}, Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "10:20:10,14,15", string(buf))
+}
+
+func TestSnapshotBinaryRoundTrip(t *testing.T) {
+	for i, src := range []pgtype.Snapshot{
+		{Xmin: 10, Xmax: 20, Status: pgtype.Present},
+		{Xmin: 10, Xmax: 20, Xip: []uint64{10, 14, 15}, Status: pgtype.Present},
+	} {
+		buf, err := src.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+
+		var dst pgtype.Snapshot
+		err = dst.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, src, dst, "%d", i)
+	}
+
+	var dst pgtype.Snapshot
+	require.Error(t, dst.DecodeBinary(nil, []byte{0, 0, 0, 1}))
+}
+
+func TestSnapshotIsVisible(t *testing.T) {
+	snapshot := pgtype.Snapshot{Xmin: 10, Xmax: 20, Xip: []uint64{10, 14, 15}, Status: pgtype.Present}
+
+	tests := []struct {
+		xid     uint64
+		visible bool
+	}{
+		{xid: 9, visible: true},
+		{xid: 10, visible: false},
+		{xid: 11, visible: true},
+		{xid: 14, visible: false},
+		{xid: 16, visible: true},
+		{xid: 19, visible: true},
+		{xid: 20, visible: false},
+		{xid: 25, visible: false},
+	}
+
+	for i, tt := range tests {
+		require.Equalf(t, tt.visible, snapshot.IsVisible(tt.xid), "%d: %d", i, tt.xid)
+	}
+
+	require.False(t, pgtype.Snapshot{Status: pgtype.Null}.IsVisible(1))
+}
+
+func TestSnapshotDataTypeForValue(t *testing.T) {
+	ci := pgtype.NewConnInfo()
+
+	dt, ok := ci.DataTypeForValue(&pgtype.Snapshot{})
+	require.True(t, ok)
+	require.Equal(t, "pg_snapshot", dt.Name)
+
+	dt, ok = ci.DataTypeForName("txid_snapshot")
+	require.True(t, ok)
+	require.IsType(t, &pgtype.Snapshot{}, dt.Value)
+}
@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTIDArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tid[]", []interface{}{
+		&pgtype.TIDArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TIDArray{
+			Elements: []pgtype.TID{
+				{BlockNumber: 42, OffsetNumber: 43, Status: pgtype.Present},
+				{BlockNumber: 4294967295, OffsetNumber: 65535, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TIDArray{Status: pgtype.Null},
+	})
+}
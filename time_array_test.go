@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTimeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "time[]", []interface{}{
+		&pgtype.TimeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TimeArray{
+			Elements: []pgtype.Time{
+				{Microseconds: 0, Status: pgtype.Present},
+				{Microseconds: 86399999999, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TimeArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,334 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgio"
+)
+
+// maxTimetzOffset is the largest time zone offset in seconds that PostgreSQL accepts (15:59:59).
+const maxTimetzOffset = 15*60*60 + 59*60 + 59
+
+// Timetz represents the PostgreSQL timetz type. The PostgreSQL timetz is a time of day with a time zone offset.
+//
+// Like Time, the time of day is represented as the number of microseconds since midnight so that 24:00:00 can be
+// handled. Offset is the time zone offset in seconds east of UTC as used by time.FixedZone. Note that PostgreSQL
+// itself stores the offset as seconds west of UTC.
+type Timetz struct {
+	Microseconds int64 // Number of microseconds since midnight
+	Offset       int32 // Seconds east of UTC
+	Status       Status
+}
+
+// Set converts src into a Timetz and stores in dst. The offset of a time.Time is preserved.
+func (dst *Timetz) Set(src interface{}) error {
+	if src == nil {
+		*dst = Timetz{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Timetz:
+		*dst = value
+	case *Timetz:
+		if value == nil {
+			*dst = Timetz{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case time.Time:
+		usec := int64(value.Hour())*microsecondsPerHour +
+			int64(value.Minute())*microsecondsPerMinute +
+			int64(value.Second())*microsecondsPerSecond +
+			int64(value.Nanosecond())/1000
+		_, offset := value.Zone()
+		*dst = Timetz{Microseconds: usec, Offset: int32(offset), Status: Present}
+	case *time.Time:
+		if value == nil {
+			*dst = Timetz{Status: Null}
+		} else {
+			return dst.Set(*value)
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	default:
+		if originalSrc, ok := underlyingTimeType(src); ok {
+			return dst.Set(originalSrc)
+		}
+		return fmt.Errorf("cannot convert %v to Timetz", value)
+	}
+
+	return nil
+}
+
+func (dst Timetz) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns src to dst. A time.Time destination is set to the time of day on 2000-01-01 in a time.FixedZone
+// with the offset of src.
+func (src *Timetz) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *time.Time:
+			// 24:00:00 is max allowed time in PostgreSQL, but time.Time will normalize that to 00:00:00 the next day.
+			var maxRepresentableByTime int64 = 24*60*60*1000000 - 1
+			if src.Microseconds > maxRepresentableByTime {
+				return fmt.Errorf("%d microseconds cannot be represented as time.Time", src.Microseconds)
+			}
+
+			usec := src.Microseconds
+			hours := usec / microsecondsPerHour
+			usec -= hours * microsecondsPerHour
+			minutes := usec / microsecondsPerMinute
+			usec -= minutes * microsecondsPerMinute
+			seconds := usec / microsecondsPerSecond
+			usec -= seconds * microsecondsPerSecond
+			ns := usec * 1000
+			*v = time.Date(2000, 1, 1, int(hours), int(minutes), int(seconds), int(ns), time.FixedZone("", int(src.Offset)))
+			return nil
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+// DecodeText decodes from src into dst.
+func (dst *Timetz) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Timetz{Status: Null}
+		return nil
+	}
+
+	s := string(src)
+
+	// The time of day is at least 8 characters so any sign after that is the start of the offset.
+	signPos := -1
+	if len(s) > 8 {
+		signPos = strings.IndexAny(s[8:], "+-")
+	}
+	if signPos == -1 {
+		return fmt.Errorf("cannot decode %v into Timetz", s)
+	}
+	signPos += 8
+
+	var t Time
+	if err := t.DecodeText(ci, []byte(s[:signPos])); err != nil {
+		return fmt.Errorf("cannot decode %v into Timetz", s)
+	}
+
+	offset, err := parseTimetzOffset(s[signPos:])
+	if err != nil {
+		return fmt.Errorf("cannot decode %v into Timetz", s)
+	}
+
+	*dst = Timetz{Microseconds: t.Microseconds, Offset: offset, Status: Present}
+
+	return nil
+}
+
+// parseTimetzOffset parses an offset such as +05, -08:00, +05:30, or +05:30:15 into seconds east of UTC.
+func parseTimetzOffset(s string) (int32, error) {
+	if len(s) < 3 {
+		return 0, fmt.Errorf("invalid offset: %v", s)
+	}
+
+	sign := int32(1)
+	if s[0] == '-' {
+		sign = -1
+	} else if s[0] != '+' {
+		return 0, fmt.Errorf("invalid offset: %v", s)
+	}
+
+	parts := strings.Split(s[1:], ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid offset: %v", s)
+	}
+
+	var offset int32
+	multipliers := []int32{60 * 60, 60, 1}
+	for i, part := range parts {
+		if len(part) != 2 {
+			return 0, fmt.Errorf("invalid offset: %v", s)
+		}
+		n, err := strconv.ParseUint(part, 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid offset: %v", s)
+		}
+		offset += int32(n) * multipliers[i]
+	}
+
+	return sign * offset, nil
+}
+
+// DecodeBinary decodes from src into dst.
+func (dst *Timetz) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Timetz{Status: Null}
+		return nil
+	}
+
+	if len(src) != 12 {
+		return fmt.Errorf("invalid length for timetz: %v", len(src))
+	}
+
+	usec := int64(binary.BigEndian.Uint64(src))
+	zone := int32(binary.BigEndian.Uint32(src[8:]))
+	*dst = Timetz{Microseconds: usec, Offset: -zone, Status: Present}
+
+	return nil
+}
+
+// EncodeText writes the text encoding of src into w.
+func (src Timetz) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	usec := src.Microseconds
+	hours := usec / microsecondsPerHour
+	usec -= hours * microsecondsPerHour
+	minutes := usec / microsecondsPerMinute
+	usec -= minutes * microsecondsPerMinute
+	seconds := usec / microsecondsPerSecond
+	usec -= seconds * microsecondsPerSecond
+
+	buf = append(buf, fmt.Sprintf("%02d:%02d:%02d.%06d", hours, minutes, seconds, usec)...)
+
+	return appendTimetzOffset(buf, src.Offset), nil
+}
+
+// appendTimetzOffset appends offset in the same form as PostgreSQL. Minutes and seconds are only included when they
+// are not zero.
+func appendTimetzOffset(buf []byte, offset int32) []byte {
+	if offset < 0 {
+		buf = append(buf, '-')
+		offset = -offset
+	} else {
+		buf = append(buf, '+')
+	}
+
+	hours := offset / (60 * 60)
+	minutes := offset / 60 % 60
+	seconds := offset % 60
+
+	buf = append(buf, fmt.Sprintf("%02d", hours)...)
+	if minutes != 0 || seconds != 0 {
+		buf = append(buf, fmt.Sprintf(":%02d", minutes)...)
+	}
+	if seconds != 0 {
+		buf = append(buf, fmt.Sprintf(":%02d", seconds)...)
+	}
+
+	return buf
+}
+
+// EncodeBinary writes the binary encoding of src into w.
+func (src Timetz) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	if src.Offset > maxTimetzOffset || src.Offset < -maxTimetzOffset {
+		return nil, fmt.Errorf("timetz offset out of range: %d", src.Offset)
+	}
+
+	buf = pgio.AppendInt64(buf, src.Microseconds)
+	return pgio.AppendInt32(buf, -src.Offset), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Timetz) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Timetz{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	case time.Time:
+		return dst.Set(src)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Timetz) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
+
+// MarshalJSON marshals src as a string in the PostgreSQL text format such as "13:45:00.123456+05:30".
+func (src Timetz) MarshalJSON() ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return []byte("null"), nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	if src.Status != Present {
+		return nil, errBadStatus
+	}
+
+	buf, err := src.EncodeText(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(buf))
+}
+
+func (dst *Timetz) UnmarshalJSON(b []byte) error {
+	var s *string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	if s == nil {
+		*dst = Timetz{Status: Null}
+		return nil
+	}
+
+	return dst.DecodeText(nil, []byte(*s))
+}
@@ -0,0 +1,43 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimetzArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "timetz[]", []interface{}{
+		&pgtype.TimetzArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TimetzArray{
+			Elements: []pgtype.Timetz{
+				{Microseconds: 49500500000, Offset: 19800, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TimetzArray{Status: pgtype.Null},
+	})
+}
+
+func TestTimetzArrayAssignTo(t *testing.T) {
+	var src pgtype.TimetzArray
+	err := src.Set([]time.Time{time.Date(2000, 1, 1, 13, 45, 0, 0, time.FixedZone("", -3600))})
+	require.NoError(t, err)
+
+	var dst []time.Time
+	err = src.AssignTo(&dst)
+	require.NoError(t, err)
+	require.Len(t, dst, 1)
+	_, offset := dst[0].Zone()
+	require.Equal(t, -3600, offset)
+	require.Equal(t, 13, dst[0].Hour())
+}
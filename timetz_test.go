@@ -0,0 +1,149 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimetzTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "timetz", []interface{}{
+		&pgtype.Timetz{Microseconds: 0, Offset: 0, Status: pgtype.Present},
+		&pgtype.Timetz{Microseconds: 1, Offset: 19800, Status: pgtype.Present},
+		&pgtype.Timetz{Microseconds: 86399999999, Offset: -28800, Status: pgtype.Present},
+		&pgtype.Timetz{Microseconds: 45296000000, Offset: 3600 + 45, Status: pgtype.Present},
+		&pgtype.Timetz{Status: pgtype.Null},
+	})
+}
+
+func TestTimetzDecodeText(t *testing.T) {
+	successfulTests := []struct {
+		source string
+		result pgtype.Timetz
+	}{
+		{source: "00:00:00+00", result: pgtype.Timetz{Microseconds: 0, Offset: 0, Status: pgtype.Present}},
+		{source: "13:45:00.5+05:30", result: pgtype.Timetz{Microseconds: 49500500000, Offset: 19800, Status: pgtype.Present}},
+		{source: "13:45:00.123456-08", result: pgtype.Timetz{Microseconds: 49500123456, Offset: -28800, Status: pgtype.Present}},
+		{source: "24:00:00+00", result: pgtype.Timetz{Microseconds: 86400000000, Offset: 0, Status: pgtype.Present}},
+		{source: "01:02:03+01:00:45", result: pgtype.Timetz{Microseconds: 3723000000, Offset: 3645, Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Timetz
+		err := r.DecodeText(nil, []byte(tt.source))
+		require.NoErrorf(t, err, "%d: %s", i, tt.source)
+		require.Equalf(t, tt.result, r, "%d: %s", i, tt.source)
+	}
+
+	for i, source := range []string{"", "13:45:00", "13:45:00+5", "13:45:00+05:3", "13:45:00 05", "ab:cd:ef+00"} {
+		var r pgtype.Timetz
+		err := r.DecodeText(nil, []byte(source))
+		require.Errorf(t, err, "%d: %s", i, source)
+	}
+}
+
+func TestTimetzEncodeText(t *testing.T) {
+	tests := []struct {
+		source pgtype.Timetz
+		result string
+	}{
+		{source: pgtype.Timetz{Microseconds: 0, Offset: 0, Status: pgtype.Present}, result: "00:00:00.000000+00"},
+		{source: pgtype.Timetz{Microseconds: 49500500000, Offset: 19800, Status: pgtype.Present}, result: "13:45:00.500000+05:30"},
+		{source: pgtype.Timetz{Microseconds: 49500123456, Offset: -28800, Status: pgtype.Present}, result: "13:45:00.123456-08"},
+		{source: pgtype.Timetz{Microseconds: 3723000000, Offset: -3645, Status: pgtype.Present}, result: "01:02:03.000000-01:00:45"},
+	}
+
+	for i, tt := range tests {
+		buf, err := tt.source.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(buf), "%d", i)
+	}
+}
+
+func TestTimetzBinary(t *testing.T) {
+	src := pgtype.Timetz{Microseconds: 49500123456, Offset: 19800, Status: pgtype.Present}
+
+	buf, err := src.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, buf, 12)
+	// PostgreSQL stores the offset as seconds west of UTC.
+	require.Equal(t, []byte{0xff, 0xff, 0xb2, 0xa8}, buf[8:])
+
+	var dst pgtype.Timetz
+	err = dst.DecodeBinary(nil, buf)
+	require.NoError(t, err)
+	require.Equal(t, src, dst)
+
+	_, err = pgtype.Timetz{Offset: 16 * 60 * 60, Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.Error(t, err)
+}
+
+func TestTimetzSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.Timetz
+	}{
+		{source: time.Date(1900, 1, 1, 1, 0, 0, 0, time.UTC), result: pgtype.Timetz{Microseconds: 3600000000, Offset: 0, Status: pgtype.Present}},
+		{source: time.Date(2015, 1, 1, 13, 45, 0, 2000, time.FixedZone("", 19800)), result: pgtype.Timetz{Microseconds: 49500000002, Offset: 19800, Status: pgtype.Present}},
+		{source: func(t time.Time) *time.Time { return &t }(time.Date(2015, 1, 1, 0, 0, 0, 0, time.FixedZone("", -28800))), result: pgtype.Timetz{Microseconds: 0, Offset: -28800, Status: pgtype.Present}},
+		{source: "13:45:00+05:30", result: pgtype.Timetz{Microseconds: 49500000000, Offset: 19800, Status: pgtype.Present}},
+		{source: nil, result: pgtype.Timetz{Status: pgtype.Null}},
+		{source: (*time.Time)(nil), result: pgtype.Timetz{Status: pgtype.Null}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.Timetz
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+}
+
+func TestTimetzAssignTo(t *testing.T) {
+	src := pgtype.Timetz{Microseconds: 49500000002, Offset: 19800, Status: pgtype.Present}
+
+	var tim time.Time
+	err := src.AssignTo(&tim)
+	require.NoError(t, err)
+	require.True(t, time.Date(2000, 1, 1, 13, 45, 0, 2000, time.FixedZone("", 19800)).Equal(tim))
+	_, offset := tim.Zone()
+	require.Equal(t, 19800, offset)
+
+	var ptim *time.Time
+	err = src.AssignTo(&ptim)
+	require.NoError(t, err)
+	require.True(t, tim.Equal(*ptim))
+
+	src = pgtype.Timetz{Microseconds: 86400000000, Status: pgtype.Present}
+	err = src.AssignTo(&tim)
+	require.Error(t, err)
+
+	src = pgtype.Timetz{Status: pgtype.Null}
+	err = src.AssignTo(&ptim)
+	require.NoError(t, err)
+	require.Nil(t, ptim)
+}
+
+func TestTimetzMarshalJSON(t *testing.T) {
+	successfulTests := []struct {
+		source pgtype.Timetz
+		result string
+	}{
+		{source: pgtype.Timetz{Status: pgtype.Null}, result: "null"},
+		{source: pgtype.Timetz{Microseconds: 49500500000, Offset: 19800, Status: pgtype.Present}, result: `"13:45:00.500000+05:30"`},
+	}
+
+	for i, tt := range successfulTests {
+		r, err := tt.source.MarshalJSON()
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, string(r), "%d", i)
+
+		var dst pgtype.Timetz
+		err = dst.UnmarshalJSON(r)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.source, dst, "%d", i)
+	}
+}
@@ -0,0 +1,29 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTsmultirangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tsmultirange[]", []interface{}{
+		&pgtype.TsmultirangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TsmultirangeArray{
+			Elements: []pgtype.Tsmultirange{
+				{Status: pgtype.Present},
+				{Ranges: []pgtype.Tsrange{{Lower: pgtype.Timestamp{Time: time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}, Upper: pgtype.Timestamp{Time: time.Date(2028, 1, 1, 0, 23, 12, 0, time.UTC), Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TsmultirangeArray{Status: pgtype.Null},
+	})
+}
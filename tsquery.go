@@ -0,0 +1,715 @@
+package pgtype
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// TSQueryNodeType is the type of a node in a TSQuery.
+type TSQueryNodeType uint8
+
+const (
+	TSQueryLexeme TSQueryNodeType = iota + 1
+	TSQueryNot
+	TSQueryAnd
+	TSQueryOr
+	TSQueryPhrase
+)
+
+// Values used by the tsquery binary format.
+const (
+	tsQueryItemValue    = 1
+	tsQueryItemOperator = 2
+
+	tsQueryOperatorNot    = 1
+	tsQueryOperatorAnd    = 2
+	tsQueryOperatorOr     = 3
+	tsQueryOperatorPhrase = 4
+)
+
+// maxTSQueryPhraseDistance is the largest distance allowed in a phrase operator.
+const maxTSQueryPhraseDistance = 1 << 14
+
+// TSQueryNode is a node in the operator tree of a TSQuery.
+//
+// A TSQueryLexeme node uses Lexeme, Weights, and Prefix. Weights restricts the match to lexeme positions with the
+// given weights; if it is empty any weight matches. Prefix is true for a prefix match such as 'super':*.
+//
+// A TSQueryNot node uses Left as its operand. TSQueryAnd, TSQueryOr, and TSQueryPhrase nodes use Left and Right.
+// Distance is the distance of a TSQueryPhrase node. <-> is a distance of 1.
+type TSQueryNode struct {
+	Type TSQueryNodeType
+
+	Lexeme  string
+	Weights []TSWeight
+	Prefix  bool
+
+	Distance uint16
+
+	Left  *TSQueryNode
+	Right *TSQueryNode
+}
+
+// TSQuery represents a PostgreSQL tsquery. Root is nil for an empty query.
+type TSQuery struct {
+	Root   *TSQueryNode
+	Status Status
+}
+
+func (dst *TSQuery) Set(src interface{}) error {
+	if src == nil {
+		*dst = TSQuery{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case TSQuery:
+		*dst = value
+	case *TSQuery:
+		if value == nil {
+			*dst = TSQuery{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case *TSQueryNode:
+		*dst = TSQuery{Root: value, Status: Present}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = TSQuery{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to TSQuery", src)
+	}
+
+	return nil
+}
+
+func (dst TSQuery) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *TSQuery) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *TSQuery) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = TSQuery{Status: Null}
+		return nil
+	}
+
+	root, err := parseTSQuery(string(src))
+	if err != nil {
+		return err
+	}
+
+	*dst = TSQuery{Root: root, Status: Present}
+	return nil
+}
+
+func (dst *TSQuery) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = TSQuery{Status: Null}
+		return nil
+	}
+
+	if len(src) < 4 {
+		return fmt.Errorf("tsquery too short: %v", len(src))
+	}
+
+	itemCount := int(int32(binary.BigEndian.Uint32(src)))
+	if itemCount < 0 {
+		return fmt.Errorf("invalid tsquery item count: %d", itemCount)
+	}
+
+	d := &tsQueryBinaryDecoder{src: src, rp: 4, remaining: itemCount}
+
+	var root *TSQueryNode
+	if itemCount > 0 {
+		var err error
+		root, err = d.decodeNode()
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.remaining != 0 {
+		return fmt.Errorf("invalid tsquery: %d unused items", d.remaining)
+	}
+	if d.rp != len(src) {
+		return fmt.Errorf("invalid tsquery: %d trailing bytes", len(src)-d.rp)
+	}
+
+	*dst = TSQuery{Root: root, Status: Present}
+	return nil
+}
+
+type tsQueryBinaryDecoder struct {
+	src       []byte
+	rp        int
+	remaining int
+}
+
+// decodeNode decodes the node at the current position. The server sends nodes in prefix order with the right operand
+// of a binary operator before the left operand.
+func (d *tsQueryBinaryDecoder) decodeNode() (*TSQueryNode, error) {
+	if d.remaining == 0 {
+		return nil, fmt.Errorf("invalid tsquery: missing operand")
+	}
+	d.remaining--
+
+	if len(d.src[d.rp:]) < 2 {
+		return nil, fmt.Errorf("invalid tsquery: unexpected end of data")
+	}
+	itemType := d.src[d.rp]
+	d.rp++
+
+	switch itemType {
+	case tsQueryItemValue:
+		if len(d.src[d.rp:]) < 2 {
+			return nil, fmt.Errorf("invalid tsquery: unexpected end of data")
+		}
+		weightMask := d.src[d.rp]
+		prefix := d.src[d.rp+1]
+		d.rp += 2
+
+		if weightMask > 0xF {
+			return nil, fmt.Errorf("invalid tsquery: invalid weight bitmap %d", weightMask)
+		}
+
+		end := bytes.IndexByte(d.src[d.rp:], 0)
+		if end == -1 {
+			return nil, fmt.Errorf("invalid tsquery: unterminated lexeme")
+		}
+		node := &TSQueryNode{
+			Type:    TSQueryLexeme,
+			Lexeme:  string(d.src[d.rp : d.rp+end]),
+			Weights: tsWeightsFromMask(weightMask),
+			Prefix:  prefix != 0,
+		}
+		d.rp += end + 1
+
+		return node, nil
+	case tsQueryItemOperator:
+		operator := d.src[d.rp]
+		d.rp++
+
+		node := &TSQueryNode{}
+		switch operator {
+		case tsQueryOperatorNot:
+			node.Type = TSQueryNot
+			operand, err := d.decodeNode()
+			if err != nil {
+				return nil, err
+			}
+			node.Left = operand
+			return node, nil
+		case tsQueryOperatorAnd:
+			node.Type = TSQueryAnd
+		case tsQueryOperatorOr:
+			node.Type = TSQueryOr
+		case tsQueryOperatorPhrase:
+			node.Type = TSQueryPhrase
+			if len(d.src[d.rp:]) < 2 {
+				return nil, fmt.Errorf("invalid tsquery: unexpected end of data")
+			}
+			node.Distance = binary.BigEndian.Uint16(d.src[d.rp:])
+			d.rp += 2
+		default:
+			return nil, fmt.Errorf("invalid tsquery: unknown operator %d", operator)
+		}
+
+		var err error
+		node.Right, err = d.decodeNode()
+		if err != nil {
+			return nil, err
+		}
+		node.Left, err = d.decodeNode()
+		if err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	default:
+		return nil, fmt.Errorf("invalid tsquery: unknown item type %d", itemType)
+	}
+}
+
+func (src TSQuery) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	// Ensure an empty tsquery is not encoded as NULL.
+	if buf == nil {
+		buf = []byte{}
+	}
+
+	if src.Root == nil {
+		return buf, nil
+	}
+
+	return appendTSQueryNodeText(buf, src.Root, -1, false)
+}
+
+// tsQueryPriority returns the binding strength of the operator of node.
+func tsQueryPriority(node *TSQueryNode) int {
+	switch node.Type {
+	case TSQueryNot:
+		return 4
+	case TSQueryPhrase:
+		return 3
+	case TSQueryAnd:
+		return 2
+	case TSQueryOr:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// appendTSQueryNodeText appends node in infix form. Parentheses are added exactly where the server would add them so
+// the output matches the server's output for the same query.
+func appendTSQueryNodeText(buf []byte, node *TSQueryNode, parentPriority int, rightPhraseOperand bool) ([]byte, error) {
+	if node == nil {
+		return nil, fmt.Errorf("tsquery operator is missing an operand")
+	}
+
+	switch node.Type {
+	case TSQueryLexeme:
+		buf = appendTSQuoted(buf, node.Lexeme)
+		if len(node.Weights) > 0 || node.Prefix {
+			buf = append(buf, ':')
+			if node.Prefix {
+				buf = append(buf, '*')
+			}
+			weightMask, err := tsWeightMask(node.Weights)
+			if err != nil {
+				return nil, err
+			}
+			for _, w := range tsWeightsFromMask(weightMask) {
+				buf = append(buf, w.String()...)
+			}
+		}
+		return buf, nil
+	case TSQueryNot:
+		priority := tsQueryPriority(node)
+		if priority < parentPriority {
+			buf = append(buf, "( "...)
+		}
+		buf = append(buf, '!')
+
+		var err error
+		buf, err = appendTSQueryNodeText(buf, node.Left, priority, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if priority < parentPriority {
+			buf = append(buf, " )"...)
+		}
+		return buf, nil
+	case TSQueryAnd, TSQueryOr, TSQueryPhrase:
+		priority := tsQueryPriority(node)
+		needParens := priority < parentPriority || (node.Type == TSQueryPhrase && rightPhraseOperand)
+		if needParens {
+			buf = append(buf, "( "...)
+		}
+
+		var err error
+		buf, err = appendTSQueryNodeText(buf, node.Left, priority, false)
+		if err != nil {
+			return nil, err
+		}
+
+		switch node.Type {
+		case TSQueryAnd:
+			buf = append(buf, " & "...)
+		case TSQueryOr:
+			buf = append(buf, " | "...)
+		case TSQueryPhrase:
+			if node.Distance == 1 {
+				buf = append(buf, " <-> "...)
+			} else {
+				buf = append(buf, " <"...)
+				buf = strconv.AppendUint(buf, uint64(node.Distance), 10)
+				buf = append(buf, "> "...)
+			}
+		}
+
+		buf, err = appendTSQueryNodeText(buf, node.Right, priority, node.Type == TSQueryPhrase)
+		if err != nil {
+			return nil, err
+		}
+
+		if needParens {
+			buf = append(buf, " )"...)
+		}
+		return buf, nil
+	default:
+		return nil, fmt.Errorf("unknown tsquery node type: %d", node.Type)
+	}
+}
+
+func (src TSQuery) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	sp := len(buf)
+	buf = pgio.AppendInt32(buf, 0)
+
+	if src.Root == nil {
+		return buf, nil
+	}
+
+	buf, itemCount, err := appendTSQueryNodeBinary(buf, src.Root)
+	if err != nil {
+		return nil, err
+	}
+	pgio.SetInt32(buf[sp:], int32(itemCount))
+
+	return buf, nil
+}
+
+func appendTSQueryNodeBinary(buf []byte, node *TSQueryNode) ([]byte, int, error) {
+	if node == nil {
+		return nil, 0, fmt.Errorf("tsquery operator is missing an operand")
+	}
+
+	switch node.Type {
+	case TSQueryLexeme:
+		if strings.IndexByte(node.Lexeme, 0) != -1 {
+			return nil, 0, fmt.Errorf("tsquery lexeme cannot contain NUL byte: %q", node.Lexeme)
+		}
+		weightMask, err := tsWeightMask(node.Weights)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		buf = append(buf, tsQueryItemValue, weightMask)
+		if node.Prefix {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		buf = append(buf, node.Lexeme...)
+		buf = append(buf, 0)
+		return buf, 1, nil
+	case TSQueryNot:
+		buf = append(buf, tsQueryItemOperator, tsQueryOperatorNot)
+		buf, count, err := appendTSQueryNodeBinary(buf, node.Left)
+		if err != nil {
+			return nil, 0, err
+		}
+		return buf, count + 1, nil
+	case TSQueryAnd, TSQueryOr, TSQueryPhrase:
+		buf = append(buf, tsQueryItemOperator)
+		switch node.Type {
+		case TSQueryAnd:
+			buf = append(buf, tsQueryOperatorAnd)
+		case TSQueryOr:
+			buf = append(buf, tsQueryOperatorOr)
+		case TSQueryPhrase:
+			if node.Distance > maxTSQueryPhraseDistance {
+				return nil, 0, fmt.Errorf("tsquery phrase distance out of range: %d", node.Distance)
+			}
+			buf = append(buf, tsQueryOperatorPhrase)
+			buf = pgio.AppendUint16(buf, node.Distance)
+		}
+
+		buf, rightCount, err := appendTSQueryNodeBinary(buf, node.Right)
+		if err != nil {
+			return nil, 0, err
+		}
+		buf, leftCount, err := appendTSQueryNodeBinary(buf, node.Left)
+		if err != nil {
+			return nil, 0, err
+		}
+		return buf, rightCount + leftCount + 1, nil
+	default:
+		return nil, 0, fmt.Errorf("unknown tsquery node type: %d", node.Type)
+	}
+}
+
+// tsWeightMask converts weights to the bitmap used by the server.
+func tsWeightMask(weights []TSWeight) (byte, error) {
+	var mask byte
+	for _, w := range weights {
+		if w > TSWeightA {
+			return 0, fmt.Errorf("invalid tsquery weight: %d", w)
+		}
+		mask |= 1 << w
+	}
+	return mask, nil
+}
+
+// tsWeightsFromMask converts a weight bitmap to weights in the order the server outputs them.
+func tsWeightsFromMask(mask byte) []TSWeight {
+	var weights []TSWeight
+	for w := TSWeightA; ; w-- {
+		if mask&(1<<w) != 0 {
+			weights = append(weights, w)
+		}
+		if w == TSWeightD {
+			break
+		}
+	}
+	return weights
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *TSQuery) Scan(src interface{}) error {
+	if src == nil {
+		*dst = TSQuery{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src TSQuery) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
+
+func isTSQueryOperator(b byte) bool {
+	switch b {
+	case '!', '&', '|', '(', ')', '<':
+		return true
+	}
+	return false
+}
+
+// tsQueryParser parses the tsquery text format. Operators bind from tightest to loosest in the order !, <->, &, |.
+// Binary operators are left associative.
+type tsQueryParser struct {
+	tsScanner
+}
+
+func parseTSQuery(src string) (*TSQueryNode, error) {
+	p := &tsQueryParser{tsScanner{src: src}}
+
+	p.skipSpace()
+	if p.eof() {
+		return nil, nil
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid tsquery: %v", err)
+	}
+
+	p.skipSpace()
+	if !p.eof() {
+		return nil, fmt.Errorf("invalid tsquery: syntax error at position %d", p.pos)
+	}
+
+	return root, nil
+}
+
+func (p *tsQueryParser) parseOr() (*TSQueryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+		if p.peek() != '|' {
+			return left, nil
+		}
+		p.pos++
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &TSQueryNode{Type: TSQueryOr, Left: left, Right: right}
+	}
+}
+
+func (p *tsQueryParser) parseAnd() (*TSQueryNode, error) {
+	left, err := p.parsePhrase()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+		if p.peek() != '&' {
+			return left, nil
+		}
+		p.pos++
+
+		right, err := p.parsePhrase()
+		if err != nil {
+			return nil, err
+		}
+		left = &TSQueryNode{Type: TSQueryAnd, Left: left, Right: right}
+	}
+}
+
+func (p *tsQueryParser) parsePhrase() (*TSQueryNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+		if p.peek() != '<' {
+			return left, nil
+		}
+
+		distance, err := p.parsePhraseOperator()
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &TSQueryNode{Type: TSQueryPhrase, Distance: distance, Left: left, Right: right}
+	}
+}
+
+// parsePhraseOperator parses <-> or <N>.
+func (p *tsQueryParser) parsePhraseOperator() (uint16, error) {
+	start := p.pos
+	p.pos++ // '<'
+
+	if strings.HasPrefix(p.src[p.pos:], "->") {
+		p.pos += 2
+		return 1, nil
+	}
+
+	digitsStart := p.pos
+	for !p.eof() && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+		p.pos++
+	}
+	if p.pos == digitsStart || p.peek() != '>' {
+		return 0, fmt.Errorf("syntax error at position %d", start)
+	}
+
+	n, err := strconv.ParseUint(p.src[digitsStart:p.pos], 10, 16)
+	if err != nil || n > maxTSQueryPhraseDistance {
+		return 0, fmt.Errorf("distance in phrase operator must be an integer value between zero and %d inclusive", maxTSQueryPhraseDistance)
+	}
+	p.pos++ // '>'
+
+	return uint16(n), nil
+}
+
+func (p *tsQueryParser) parseNot() (*TSQueryNode, error) {
+	p.skipSpace()
+	if p.peek() != '!' {
+		return p.parsePrimary()
+	}
+	p.pos++
+
+	operand, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	return &TSQueryNode{Type: TSQueryNot, Left: operand}, nil
+}
+
+func (p *tsQueryParser) parsePrimary() (*TSQueryNode, error) {
+	p.skipSpace()
+
+	if p.peek() == '(' {
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("syntax error at position %d: expected )", p.pos)
+		}
+		p.pos++
+		return node, nil
+	}
+
+	if p.eof() || p.peek() == ')' || isTSQueryOperator(p.peek()) {
+		return nil, fmt.Errorf("syntax error at position %d: expected operand", p.pos)
+	}
+
+	lexeme, err := p.lexeme(isTSQueryOperator)
+	if err != nil {
+		return nil, err
+	}
+	node := &TSQueryNode{Type: TSQueryLexeme, Lexeme: lexeme}
+
+	if p.peek() == ':' {
+		p.pos++
+		var weightMask byte
+		for !p.eof() {
+			if p.peek() == '*' {
+				node.Prefix = true
+			} else if w, ok := parseTSWeight(p.peek()); ok {
+				weightMask |= 1 << w
+			} else {
+				break
+			}
+			p.pos++
+		}
+		node.Weights = tsWeightsFromMask(weightMask)
+	}
+
+	return node, nil
+}
@@ -0,0 +1,27 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTSQueryArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tsquery[]", []interface{}{
+		&pgtype.TSQueryArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TSQueryArray{
+			Elements: []pgtype.TSQuery{
+				{Root: &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "cat", Prefix: true}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TSQueryArray{Status: pgtype.Null},
+	})
+}
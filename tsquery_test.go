@@ -0,0 +1,149 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTSQueryTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tsquery", []interface{}{
+		&pgtype.TSQuery{Status: pgtype.Present},
+		&pgtype.TSQuery{Root: &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "cat"}, Status: pgtype.Present},
+		&pgtype.TSQuery{
+			Root: &pgtype.TSQueryNode{
+				Type:  pgtype.TSQueryAnd,
+				Left:  &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "fat", Weights: []pgtype.TSWeight{pgtype.TSWeightA, pgtype.TSWeightB}},
+				Right: &pgtype.TSQueryNode{Type: pgtype.TSQueryNot, Left: &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "super", Prefix: true}},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.TSQuery{
+			Root: &pgtype.TSQueryNode{
+				Type: pgtype.TSQueryOr,
+				Left: &pgtype.TSQueryNode{
+					Type:     pgtype.TSQueryPhrase,
+					Distance: 1,
+					Left:     &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "a"},
+					Right:    &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "b"},
+				},
+				Right: &pgtype.TSQueryNode{
+					Type:     pgtype.TSQueryPhrase,
+					Distance: 3,
+					Left:     &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "don't"},
+					Right:    &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "d"},
+				},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.TSQuery{Status: pgtype.Null},
+	})
+}
+
+func TestTSQueryTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected string
+	}{
+		{src: "", expected: ""},
+		{src: "cat", expected: "'cat'"},
+		{src: "fat & rat", expected: "'fat' & 'rat'"},
+		{src: "fat & (rat | cat)", expected: "'fat' & ( 'rat' | 'cat' )"},
+		{src: "(fat & rat) | cat", expected: "'fat' & 'rat' | 'cat'"},
+		{src: "fat | rat & cat", expected: "'fat' | 'rat' & 'cat'"},
+		{src: "!fat", expected: "!'fat'"},
+		{src: "!(fat | rat)", expected: "!( 'fat' | 'rat' )"},
+		{src: "!!fat", expected: "!!'fat'"},
+		{src: "fat <-> rat", expected: "'fat' <-> 'rat'"},
+		{src: "fat <2> rat", expected: "'fat' <2> 'rat'"},
+		{src: "fat <0> rat", expected: "'fat' <0> 'rat'"},
+		{src: "a <-> (b <-> c)", expected: "'a' <-> ( 'b' <-> 'c' )"},
+		{src: "(a <-> b) <-> c", expected: "'a' <-> 'b' <-> 'c'"},
+		{src: "a <-> b & c", expected: "'a' <-> 'b' & 'c'"},
+		{src: "a <-> (b & c)", expected: "'a' <-> ( 'b' & 'c' )"},
+		{src: "super:*", expected: "'super':*"},
+		{src: "super:ba*", expected: "'super':*AB"},
+		{src: "'fat rat':D", expected: "'fat rat':D"},
+		{src: `'don''t' & 'back\\slash'`, expected: `'don''t' & 'back\\slash'`},
+	}
+
+	for i, tt := range tests {
+		var q pgtype.TSQuery
+		err := q.DecodeText(nil, []byte(tt.src))
+		require.NoErrorf(t, err, "%d: %s", i, tt.src)
+
+		buf, err := q.EncodeText(nil, nil)
+		require.NoErrorf(t, err, "%d: %s", i, tt.src)
+		require.Equalf(t, tt.expected, string(buf), "%d: %s", i, tt.src)
+
+		buf, err = q.EncodeBinary(nil, nil)
+		require.NoErrorf(t, err, "%d: %s", i, tt.src)
+
+		var r pgtype.TSQuery
+		err = r.DecodeBinary(nil, buf)
+		require.NoErrorf(t, err, "%d: %s", i, tt.src)
+		require.Equalf(t, q, r, "%d: %s", i, tt.src)
+	}
+}
+
+func TestTSQueryDecodeTextStructure(t *testing.T) {
+	var q pgtype.TSQuery
+	err := q.DecodeText(nil, []byte("a & b & c"))
+	require.NoError(t, err)
+
+	expected := &pgtype.TSQueryNode{
+		Type: pgtype.TSQueryAnd,
+		Left: &pgtype.TSQueryNode{
+			Type:  pgtype.TSQueryAnd,
+			Left:  &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "a"},
+			Right: &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "b"},
+		},
+		Right: &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "c"},
+	}
+	require.Equal(t, expected, q.Root)
+}
+
+func TestTSQueryDecodeTextErrors(t *testing.T) {
+	for i, src := range []string{"a b", "a &", "& a", "(a", "a)", "a <-", "a <x> b", "a <16385> b", "'a", "!"} {
+		var q pgtype.TSQuery
+		err := q.DecodeText(nil, []byte(src))
+		require.Errorf(t, err, "%d: %s", i, src)
+	}
+}
+
+func TestTSQueryBinaryFormat(t *testing.T) {
+	q := pgtype.TSQuery{
+		Root: &pgtype.TSQueryNode{
+			Type:     pgtype.TSQueryPhrase,
+			Distance: 2,
+			Left:     &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "a", Weights: []pgtype.TSWeight{pgtype.TSWeightA}},
+			Right:    &pgtype.TSQueryNode{Type: pgtype.TSQueryLexeme, Lexeme: "b", Prefix: true},
+		},
+		Status: pgtype.Present,
+	}
+
+	buf, err := q.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+
+	// The right operand is sent before the left operand.
+	expected := []byte{
+		0, 0, 0, 3,
+		2, 4, 0, 2,
+		1, 0, 1, 'b', 0,
+		1, 8, 0, 'a', 0,
+	}
+	require.Equal(t, expected, buf)
+}
+
+func TestTSQueryAssignTo(t *testing.T) {
+	var q pgtype.TSQuery
+	err := q.Set("fat & !rat")
+	require.NoError(t, err)
+
+	var s string
+	err = q.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, "'fat' & !'rat'", s)
+}
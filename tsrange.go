@@ -30,9 +30,40 @@ func (dst *Tsrange) Set(src interface{}) error {
 	case string:
 		return dst.DecodeText(nil, []byte(value))
 	default:
-		return fmt.Errorf("cannot convert %v to Tsrange", src)
+		gr, ok, err := rangeFromGoValue(src)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("cannot convert %v to Tsrange", src)
+		}
+		return dst.setGenericRange(gr)
+	}
+
+	return nil
+}
+
+func (dst *Tsrange) setGenericRange(gr *genericRange) error {
+	if gr == nil {
+		*dst = Tsrange{Status: Null}
+		return nil
 	}
 
+	r := Tsrange{LowerType: gr.LowerType, UpperType: gr.UpperType, Status: Present}
+
+	if r.LowerType == Inclusive || r.LowerType == Exclusive {
+		if err := r.Lower.Set(gr.Lower); err != nil {
+			return err
+		}
+	}
+
+	if r.UpperType == Inclusive || r.UpperType == Exclusive {
+		if err := r.Upper.Set(gr.Upper); err != nil {
+			return err
+		}
+	}
+
+	*dst = r
 	return nil
 }
 
@@ -47,8 +78,32 @@ func (dst Tsrange) Get() interface{} {
 	}
 }
 
+// AssignTo assigns src to dst. dst may be a pointer to a struct with Lower and Upper fields or a pointer to a two
+// element array. A struct may also have LowerType and UpperType BoundType fields, LowerInclusive and UpperInclusive
+// bool fields, and an Empty bool field to represent bound types and empty ranges. Without them only ranges in
+// [lower,upper) form can be assigned. Unbounded bounds are assigned as nil to pointer fields.
 func (src *Tsrange) AssignTo(dst interface{}) error {
-	return fmt.Errorf("cannot assign %v to %T", src, dst)
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *Tsrange:
+			*v = *src
+			return nil
+		default:
+			if isRange, err := assignRangeToGoValue(&src.Lower, &src.Upper, src.LowerType, src.UpperType, dst); isRange {
+				return err
+			}
+
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("cannot assign %v to %T", src, dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
 }
 
 func (dst *Tsrange) DecodeText(ci *ConnInfo, src []byte) error {
@@ -265,3 +320,154 @@ func (dst *Tsrange) Scan(src interface{}) error {
 func (src Tsrange) Value() (driver.Value, error) {
 	return EncodeValueText(src)
 }
+
+func (src Tsrange) rangeOps() rangeOps {
+
+	return rangeOps{compare: compareTimestamp}
+
+}
+
+// rangeBounds returns the canonical form of src so operations give the same results as the server which only ever
+// operates on canonical ranges.
+func (src Tsrange) rangeBounds() rangeBounds {
+	return src.rangeOps().canonical(newRangeBounds(src.Lower, src.Upper, src.LowerType, src.UpperType))
+}
+
+func (dst *Tsrange) setRangeBounds(rb rangeBounds) {
+	if rb.empty {
+		*dst = Tsrange{LowerType: Empty, UpperType: Empty, Status: Present}
+		return
+	}
+
+	*dst = Tsrange{LowerType: rb.lowerType(), UpperType: rb.upperType(), Status: Present}
+	if !rb.lower.infinite {
+		dst.Lower = rb.lower.value.(Timestamp)
+	}
+	if !rb.upper.infinite {
+		dst.Upper = rb.upper.value.(Timestamp)
+	}
+}
+
+// SetCanonical is like Set but converts the result to canonical form as described by Canonical.
+func (dst *Tsrange) SetCanonical(src interface{}) error {
+	if err := dst.Set(src); err != nil {
+		return err
+	}
+
+	*dst = dst.Canonical()
+	return nil
+}
+
+// Canonical returns src in the form the server would return it. Ranges that contain no points such as [5,5) are
+// converted to the empty range.
+// If src is not present it is returned unchanged. Encode the result of Canonical to send a value in canonical form.
+func (src Tsrange) Canonical() Tsrange {
+	if src.Status != Present {
+		return src
+	}
+
+	var result Tsrange
+	result.setRangeBounds(src.rangeBounds())
+	return result
+}
+
+// Equal returns true if src and other represent the same range. It is equivalent to the PostgreSQL = operator applied
+// to the canonical forms of src and other. Two values that are not present are equal if their Status is the same.
+func (src Tsrange) Equal(other Tsrange) bool {
+	if src.Status != Present || other.Status != Present {
+		return src.Status == other.Status
+	}
+
+	return src.rangeOps().equal(src.rangeBounds(), other.rangeBounds())
+}
+
+// IsEmpty returns true if src is the empty range or a range that contains no points such as [5,5).
+func (src Tsrange) IsEmpty() bool {
+	return src.Status == Present && src.rangeBounds().empty
+}
+
+// ContainsElement returns true if elem is within src. It is equivalent to the PostgreSQL @> operator with an element
+// operand. It returns false if src or elem is not present.
+func (src Tsrange) ContainsElement(elem Timestamp) bool {
+	if src.Status != Present || elem.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().containsElement(src.rangeBounds(), elem)
+}
+
+// ContainsRange returns true if other is within src. It is equivalent to the PostgreSQL @> operator. Every range
+// contains the empty range. It returns false if src or other is not present.
+func (src Tsrange) ContainsRange(other Tsrange) bool {
+	if src.Status != Present || other.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().contains(src.rangeBounds(), other.rangeBounds())
+}
+
+// Overlaps returns true if src and other have points in common. It is equivalent to the PostgreSQL && operator. It
+// returns false if src or other is not present.
+func (src Tsrange) Overlaps(other Tsrange) bool {
+	if src.Status != Present || other.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().overlaps(src.rangeBounds(), other.rangeBounds())
+}
+
+// Adjacent returns true if src and other are adjacent. It is equivalent to the PostgreSQL -|- operator. It returns
+// false if src or other is not present.
+func (src Tsrange) Adjacent(other Tsrange) bool {
+	if src.Status != Present || other.Status != Present {
+		return false
+	}
+
+	return src.rangeOps().adjacent(src.rangeBounds(), other.rangeBounds())
+}
+
+// Union returns the union of src and other. It is equivalent to the PostgreSQL + operator. As with PostgreSQL an error
+// is returned if the result would not be contiguous. The result is null if src or other is not present.
+func (src Tsrange) Union(other Tsrange) (Tsrange, error) {
+	if src.Status != Present || other.Status != Present {
+		return Tsrange{Status: Null}, nil
+	}
+
+	rb, err := src.rangeOps().union(src.rangeBounds(), other.rangeBounds())
+	if err != nil {
+		return Tsrange{}, err
+	}
+
+	var result Tsrange
+	result.setRangeBounds(rb)
+	return result, nil
+}
+
+// Intersect returns the intersection of src and other. It is equivalent to the PostgreSQL * operator. The result is
+// null if src or other is not present.
+func (src Tsrange) Intersect(other Tsrange) Tsrange {
+	if src.Status != Present || other.Status != Present {
+		return Tsrange{Status: Null}
+	}
+
+	var result Tsrange
+	result.setRangeBounds(src.rangeOps().intersect(src.rangeBounds(), other.rangeBounds()))
+	return result
+}
+
+// Difference returns src with other removed. It is equivalent to the PostgreSQL - operator. As with PostgreSQL an
+// error is returned if the result would not be contiguous. The result is null if src or other is not present.
+func (src Tsrange) Difference(other Tsrange) (Tsrange, error) {
+	if src.Status != Present || other.Status != Present {
+		return Tsrange{Status: Null}, nil
+	}
+
+	rb, err := src.rangeOps().difference(src.rangeBounds(), other.rangeBounds())
+	if err != nil {
+		return Tsrange{}, err
+	}
+
+	var result Tsrange
+	result.setRangeBounds(rb)
+	return result, nil
+}
@@ -0,0 +1,29 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTstzmultirangeArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tstzmultirange[]", []interface{}{
+		&pgtype.TstzmultirangeArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TstzmultirangeArray{
+			Elements: []pgtype.Tstzmultirange{
+				{Status: pgtype.Present},
+				{Ranges: []pgtype.Tstzrange{{Lower: pgtype.Timestamptz{Time: time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC), Status: pgtype.Present}, Upper: pgtype.Timestamptz{Time: time.Date(2028, 1, 1, 0, 23, 12, 0, time.UTC), Status: pgtype.Present}, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Status: pgtype.Present}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TstzmultirangeArray{Status: pgtype.Null},
+	})
+}
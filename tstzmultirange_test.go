@@ -0,0 +1,56 @@
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTstzmultirangeTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscodeEqFunc(t, "tstzmultirange", []interface{}{
+		&pgtype.Tstzmultirange{Status: pgtype.Present},
+		&pgtype.Tstzmultirange{
+			Ranges: []pgtype.Tstzrange{
+				{
+					Lower:     pgtype.Timestamptz{Time: time.Date(1990, 12, 31, 0, 0, 0, 0, time.Local), Status: pgtype.Present},
+					Upper:     pgtype.Timestamptz{Time: time.Date(2028, 1, 1, 0, 23, 12, 0, time.Local), Status: pgtype.Present},
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Exclusive,
+					Status:    pgtype.Present,
+				},
+				{
+					Lower:     pgtype.Timestamptz{Time: time.Date(2100, 1, 1, 0, 0, 0, 0, time.Local), Status: pgtype.Present},
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Unbounded,
+					Status:    pgtype.Present,
+				},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.Tstzmultirange{Status: pgtype.Null},
+	}, func(aa, bb interface{}) bool {
+		a := aa.(pgtype.Tstzmultirange)
+		b := bb.(pgtype.Tstzmultirange)
+
+		if a.Status != b.Status || len(a.Ranges) != len(b.Ranges) {
+			return false
+		}
+
+		for i := range a.Ranges {
+			ar, br := a.Ranges[i], b.Ranges[i]
+			if ar.Status != br.Status ||
+				ar.LowerType != br.LowerType ||
+				ar.UpperType != br.UpperType ||
+				!ar.Lower.Time.Equal(br.Lower.Time) ||
+				ar.Lower.Status != br.Lower.Status ||
+				!ar.Upper.Time.Equal(br.Upper.Time) ||
+				ar.Upper.Status != br.Upper.Status {
+				return false
+			}
+		}
+
+		return true
+	})
+}
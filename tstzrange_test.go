@@ -47,3 +47,54 @@ func TestTstzRangeDecodeTextInvalid(t *testing.T) {
 	err := tstzrange.DecodeText(nil, []byte(`[eeee,)`))
 	require.Error(t, err)
 }
+
+func TestTstzrangeSetAndAssignToStruct(t *testing.T) {
+	type period struct {
+		Lower          time.Time
+		Upper          time.Time
+		LowerInclusive bool
+		UpperInclusive bool
+	}
+
+	src := period{
+		Lower:          time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Upper:          time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+		LowerInclusive: true,
+	}
+
+	var r pgtype.Tstzrange
+	err := r.Set(src)
+	require.NoError(t, err)
+	require.Equal(t, pgtype.Present, r.Status)
+	require.Equal(t, pgtype.Inclusive, r.LowerType)
+	require.Equal(t, pgtype.Exclusive, r.UpperType)
+	require.True(t, src.Lower.Equal(r.Lower.Time))
+	require.True(t, src.Upper.Equal(r.Upper.Time))
+
+	var dst period
+	err = r.AssignTo(&dst)
+	require.NoError(t, err)
+	require.True(t, src.Lower.Equal(dst.Lower))
+	require.True(t, src.Upper.Equal(dst.Upper))
+	require.Equal(t, src.LowerInclusive, dst.LowerInclusive)
+	require.Equal(t, src.UpperInclusive, dst.UpperInclusive)
+
+	type openPeriod struct {
+		Lower *time.Time
+		Upper *time.Time
+	}
+
+	r = pgtype.Tstzrange{
+		Lower:     pgtype.Timestamptz{Time: src.Lower, Status: pgtype.Present},
+		LowerType: pgtype.Inclusive,
+		UpperType: pgtype.Unbounded,
+		Status:    pgtype.Present,
+	}
+
+	var open openPeriod
+	err = r.AssignTo(&open)
+	require.NoError(t, err)
+	require.NotNil(t, open.Lower)
+	require.True(t, src.Lower.Equal(*open.Lower))
+	require.Nil(t, open.Upper)
+}
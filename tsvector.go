@@ -0,0 +1,534 @@
+package pgtype
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgio"
+)
+
+// TSWeight is the weight of a lexeme position in a tsvector. It is also used for the weights that a tsquery operand
+// will match. The values match the PostgreSQL on disk representation.
+type TSWeight uint8
+
+const (
+	TSWeightD TSWeight = iota
+	TSWeightC
+	TSWeightB
+	TSWeightA
+)
+
+func (w TSWeight) String() string {
+	switch w {
+	case TSWeightA:
+		return "A"
+	case TSWeightB:
+		return "B"
+	case TSWeightC:
+		return "C"
+	case TSWeightD:
+		return "D"
+	default:
+		return fmt.Sprintf("TSWeight(%d)", uint8(w))
+	}
+}
+
+func parseTSWeight(b byte) (TSWeight, bool) {
+	switch b {
+	case 'A', 'a':
+		return TSWeightA, true
+	case 'B', 'b':
+		return TSWeightB, true
+	case 'C', 'c':
+		return TSWeightC, true
+	case 'D', 'd':
+		return TSWeightD, true
+	default:
+		return 0, false
+	}
+}
+
+// maxTSVectorPosition is the largest position that can be stored in a tsvector. Larger positions are silently reduced
+// to this value by the server.
+const maxTSVectorPosition = 1<<14 - 1
+
+// TSVectorPosition is the position of a lexeme in a document and the weight of that occurrence.
+type TSVectorPosition struct {
+	Position uint16
+	Weight   TSWeight
+}
+
+// TSLexeme is a lexeme in a tsvector. Positions may be empty.
+type TSLexeme struct {
+	Lexeme    string
+	Positions []TSVectorPosition
+}
+
+// TSVector represents a PostgreSQL tsvector. The server stores lexemes sorted and without duplicates. Values read from
+// the server will always be in that form, but it is not required when sending values to the server.
+type TSVector struct {
+	Lexemes []TSLexeme
+	Status  Status
+}
+
+func (dst *TSVector) Set(src interface{}) error {
+	if src == nil {
+		*dst = TSVector{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case TSVector:
+		*dst = value
+	case *TSVector:
+		if value == nil {
+			*dst = TSVector{Status: Null}
+		} else {
+			*dst = *value
+		}
+	case []TSLexeme:
+		if value == nil {
+			*dst = TSVector{Status: Null}
+		} else {
+			*dst = TSVector{Lexemes: value, Status: Present}
+		}
+	case string:
+		return dst.DecodeText(nil, []byte(value))
+	case *string:
+		if value == nil {
+			*dst = TSVector{Status: Null}
+		} else {
+			return dst.DecodeText(nil, []byte(*value))
+		}
+	default:
+		return fmt.Errorf("cannot convert %v to TSVector", src)
+	}
+
+	return nil
+}
+
+func (dst TSVector) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *TSVector) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case Present:
+		switch v := dst.(type) {
+		case *string:
+			buf, err := src.EncodeText(nil, nil)
+			if err != nil {
+				return err
+			}
+			*v = string(buf)
+			return nil
+		case *[]TSLexeme:
+			*v = make([]TSLexeme, len(src.Lexemes))
+			copy(*v, src.Lexemes)
+			return nil
+		default:
+			if nextDst, retry := GetAssignToDstType(dst); retry {
+				return src.AssignTo(nextDst)
+			}
+			return fmt.Errorf("unable to assign to %T", dst)
+		}
+	case Null:
+		return NullAssignTo(dst)
+	}
+
+	return fmt.Errorf("cannot decode %#v into %T", src, dst)
+}
+
+func (dst *TSVector) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = TSVector{Status: Null}
+		return nil
+	}
+
+	lexemes, err := parseTSVector(string(src))
+	if err != nil {
+		return err
+	}
+
+	*dst = TSVector{Lexemes: lexemes, Status: Present}
+	return nil
+}
+
+func (dst *TSVector) DecodeBinary(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = TSVector{Status: Null}
+		return nil
+	}
+
+	if len(src) < 4 {
+		return fmt.Errorf("tsvector too short: %v", len(src))
+	}
+
+	lexemeCount := int(int32(binary.BigEndian.Uint32(src)))
+	rp := 4
+
+	if lexemeCount < 0 {
+		return fmt.Errorf("invalid tsvector lexeme count: %d", lexemeCount)
+	}
+
+	// Each lexeme is at least a NUL terminator and a 2 byte position count.
+	if lexemeCount*3 > len(src)-rp {
+		return fmt.Errorf("invalid tsvector: too few bytes for %d lexemes", lexemeCount)
+	}
+
+	var lexemes []TSLexeme
+	if lexemeCount > 0 {
+		lexemes = make([]TSLexeme, lexemeCount)
+	}
+
+	for i := range lexemes {
+		end := bytes.IndexByte(src[rp:], 0)
+		if end == -1 {
+			return fmt.Errorf("invalid tsvector: unterminated lexeme")
+		}
+		lexemes[i].Lexeme = string(src[rp : rp+end])
+		rp += end + 1
+
+		if len(src[rp:]) < 2 {
+			return fmt.Errorf("invalid tsvector: missing position count")
+		}
+		posCount := int(binary.BigEndian.Uint16(src[rp:]))
+		rp += 2
+
+		if len(src[rp:]) < posCount*2 {
+			return fmt.Errorf("invalid tsvector: too few positions")
+		}
+
+		if posCount > 0 {
+			lexemes[i].Positions = make([]TSVectorPosition, posCount)
+			for j := range lexemes[i].Positions {
+				wep := binary.BigEndian.Uint16(src[rp:])
+				rp += 2
+				lexemes[i].Positions[j] = TSVectorPosition{Position: wep & maxTSVectorPosition, Weight: TSWeight(wep >> 14)}
+			}
+		}
+	}
+
+	if rp != len(src) {
+		return fmt.Errorf("invalid tsvector: %d trailing bytes", len(src)-rp)
+	}
+
+	*dst = TSVector{Lexemes: lexemes, Status: Present}
+	return nil
+}
+
+func (src TSVector) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	// Ensure an empty tsvector is not encoded as NULL.
+	if buf == nil {
+		buf = []byte{}
+	}
+
+	for i, lexeme := range src.Lexemes {
+		if i > 0 {
+			buf = append(buf, ' ')
+		}
+
+		buf = appendTSQuoted(buf, lexeme.Lexeme)
+
+		for j, pos := range lexeme.Positions {
+			if j == 0 {
+				buf = append(buf, ':')
+			} else {
+				buf = append(buf, ',')
+			}
+			buf = strconv.AppendUint(buf, uint64(pos.Position), 10)
+			if pos.Weight != TSWeightD {
+				buf = append(buf, pos.Weight.String()...)
+			}
+		}
+	}
+
+	return buf, nil
+}
+
+func (src TSVector) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	buf = pgio.AppendInt32(buf, int32(len(src.Lexemes)))
+
+	for _, lexeme := range src.Lexemes {
+		if strings.IndexByte(lexeme.Lexeme, 0) != -1 {
+			return nil, fmt.Errorf("tsvector lexeme cannot contain NUL byte: %q", lexeme.Lexeme)
+		}
+		buf = append(buf, lexeme.Lexeme...)
+		buf = append(buf, 0)
+
+		buf = pgio.AppendUint16(buf, uint16(len(lexeme.Positions)))
+		for _, pos := range lexeme.Positions {
+			if pos.Position == 0 || pos.Position > maxTSVectorPosition {
+				return nil, fmt.Errorf("tsvector position out of range: %d", pos.Position)
+			}
+			if pos.Weight > TSWeightA {
+				return nil, fmt.Errorf("invalid tsvector weight: %d", pos.Weight)
+			}
+			buf = pgio.AppendUint16(buf, uint16(pos.Weight)<<14|pos.Position)
+		}
+	}
+
+	return buf, nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *TSVector) Scan(src interface{}) error {
+	if src == nil {
+		*dst = TSVector{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src TSVector) Value() (driver.Value, error) {
+	return EncodeValueText(src)
+}
+
+// appendTSQuoted appends s to buf as a quoted lexeme as the server does for tsvector and tsquery values.
+func appendTSQuoted(buf []byte, s string) []byte {
+	buf = append(buf, '\'')
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			buf = append(buf, '\'', '\'')
+		case '\\':
+			buf = append(buf, '\\', '\\')
+		default:
+			buf = append(buf, s[i])
+		}
+	}
+	return append(buf, '\'')
+}
+
+// tsScanner reads the lexemes of the tsvector and tsquery text formats.
+type tsScanner struct {
+	src string
+	pos int
+}
+
+func (s *tsScanner) skipSpace() {
+	for s.pos < len(s.src) && isTSSpace(s.src[s.pos]) {
+		s.pos++
+	}
+}
+
+func (s *tsScanner) eof() bool {
+	return s.pos >= len(s.src)
+}
+
+func (s *tsScanner) peek() byte {
+	if s.eof() {
+		return 0
+	}
+	return s.src[s.pos]
+}
+
+func isTSSpace(b byte) bool {
+	switch b {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
+
+// lexeme reads a quoted or unquoted lexeme. An unquoted lexeme ends at white space, ':', or any byte for which
+// isDelimiter returns true. A backslash escapes the following byte in both forms and a doubled quote is a literal
+// quote in the quoted form.
+func (s *tsScanner) lexeme(isDelimiter func(b byte) bool) (string, error) {
+	var sb strings.Builder
+
+	if s.peek() == '\'' {
+		s.pos++
+		for {
+			if s.eof() {
+				return "", fmt.Errorf("unterminated quoted lexeme at position %d", s.pos)
+			}
+
+			b := s.src[s.pos]
+			s.pos++
+			switch b {
+			case '\\':
+				if s.eof() {
+					return "", fmt.Errorf("unexpected end after backslash")
+				}
+				sb.WriteByte(s.src[s.pos])
+				s.pos++
+			case '\'':
+				if s.peek() != '\'' {
+					return sb.String(), nil
+				}
+				sb.WriteByte('\'')
+				s.pos++
+			default:
+				sb.WriteByte(b)
+			}
+		}
+	}
+
+	start := s.pos
+	for !s.eof() {
+		b := s.src[s.pos]
+		if isTSSpace(b) || b == ':' || isDelimiter(b) {
+			break
+		}
+		s.pos++
+		if b == '\\' {
+			if s.eof() {
+				return "", fmt.Errorf("unexpected end after backslash")
+			}
+			b = s.src[s.pos]
+			s.pos++
+		}
+		sb.WriteByte(b)
+	}
+
+	if s.pos == start {
+		return "", fmt.Errorf("syntax error at position %d", s.pos)
+	}
+
+	return sb.String(), nil
+}
+
+func parseTSVector(src string) ([]TSLexeme, error) {
+	s := &tsScanner{src: src}
+	noDelimiter := func(byte) bool { return false }
+
+	var lexemes []TSLexeme
+
+	for {
+		s.skipSpace()
+		if s.eof() {
+			break
+		}
+
+		word, err := s.lexeme(noDelimiter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tsvector: %v", err)
+		}
+		lexeme := TSLexeme{Lexeme: word}
+
+		if s.peek() == ':' {
+			s.pos++
+			for {
+				start := s.pos
+				for !s.eof() && s.src[s.pos] >= '0' && s.src[s.pos] <= '9' {
+					s.pos++
+				}
+				if s.pos == start {
+					return nil, fmt.Errorf("invalid tsvector: missing position at %d", s.pos)
+				}
+
+				// Like the server, positions that are too large are reduced to the maximum rather than rejected.
+				n, err := strconv.ParseUint(s.src[start:s.pos], 10, 16)
+				if err != nil || n > maxTSVectorPosition {
+					n = maxTSVectorPosition
+				}
+				if n == 0 {
+					return nil, fmt.Errorf("invalid tsvector: wrong position info")
+				}
+
+				pos := TSVectorPosition{Position: uint16(n), Weight: TSWeightD}
+				if weight, ok := parseTSWeight(s.peek()); ok {
+					pos.Weight = weight
+					s.pos++
+				}
+				lexeme.Positions = append(lexeme.Positions, pos)
+
+				if s.peek() != ',' {
+					break
+				}
+				s.pos++
+			}
+
+			if !s.eof() && !isTSSpace(s.peek()) {
+				return nil, fmt.Errorf("invalid tsvector: syntax error at position %d", s.pos)
+			}
+		}
+
+		lexemes = append(lexemes, lexeme)
+	}
+
+	return normalizeTSVector(lexemes), nil
+}
+
+// normalizeTSVector sorts lexemes, merges duplicates, and sorts and de-duplicates positions as the server does on
+// input.
+func normalizeTSVector(lexemes []TSLexeme) []TSLexeme {
+	if len(lexemes) == 0 {
+		return lexemes
+	}
+
+	sort.SliceStable(lexemes, func(i, j int) bool { return lexemes[i].Lexeme < lexemes[j].Lexeme })
+
+	result := lexemes[:1]
+	for _, lexeme := range lexemes[1:] {
+		last := &result[len(result)-1]
+		if last.Lexeme == lexeme.Lexeme {
+			last.Positions = append(last.Positions, lexeme.Positions...)
+		} else {
+			result = append(result, lexeme)
+		}
+	}
+
+	for i := range result {
+		positions := result[i].Positions
+		if len(positions) < 2 {
+			continue
+		}
+
+		// When there are duplicate positions the server keeps the greatest weight.
+		sort.SliceStable(positions, func(a, b int) bool {
+			if positions[a].Position != positions[b].Position {
+				return positions[a].Position < positions[b].Position
+			}
+			return positions[a].Weight > positions[b].Weight
+		})
+
+		unique := positions[:1]
+		for _, pos := range positions[1:] {
+			if pos.Position != unique[len(unique)-1].Position {
+				unique = append(unique, pos)
+			}
+		}
+		result[i].Positions = unique
+	}
+
+	return result
+}
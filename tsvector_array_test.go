@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestTSVectorArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tsvector[]", []interface{}{
+		&pgtype.TSVectorArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.TSVectorArray{
+			Elements: []pgtype.TSVector{
+				{Lexemes: []pgtype.TSLexeme{{Lexeme: "cat", Positions: []pgtype.TSVectorPosition{{Position: 1, Weight: pgtype.TSWeightA}}}}, Status: pgtype.Present},
+				{Status: pgtype.Null},
+				{Status: pgtype.Present},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.TSVectorArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,140 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTSVectorTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "tsvector", []interface{}{
+		&pgtype.TSVector{Status: pgtype.Present},
+		&pgtype.TSVector{
+			Lexemes: []pgtype.TSLexeme{
+				{Lexeme: "a"},
+				{Lexeme: `back\slash`},
+				{Lexeme: "cat", Positions: []pgtype.TSVectorPosition{{Position: 1, Weight: pgtype.TSWeightA}, {Position: 5, Weight: pgtype.TSWeightD}}},
+				{Lexeme: "don't", Positions: []pgtype.TSVectorPosition{{Position: 2, Weight: pgtype.TSWeightB}}},
+				{Lexeme: "fat rat", Positions: []pgtype.TSVectorPosition{{Position: 16383, Weight: pgtype.TSWeightC}}},
+			},
+			Status: pgtype.Present,
+		},
+		&pgtype.TSVector{Status: pgtype.Null},
+	})
+}
+
+func TestTSVectorDecodeText(t *testing.T) {
+	tests := []struct {
+		src    string
+		result pgtype.TSVector
+	}{
+		{src: "", result: pgtype.TSVector{Status: pgtype.Present}},
+		{
+			src: `'a':1A,2 'cat':3`,
+			result: pgtype.TSVector{
+				Lexemes: []pgtype.TSLexeme{
+					{Lexeme: "a", Positions: []pgtype.TSVectorPosition{{Position: 1, Weight: pgtype.TSWeightA}, {Position: 2, Weight: pgtype.TSWeightD}}},
+					{Lexeme: "cat", Positions: []pgtype.TSVectorPosition{{Position: 3, Weight: pgtype.TSWeightD}}},
+				},
+				Status: pgtype.Present,
+			},
+		},
+		{
+			src: `rat fat:2b,1c fat:2A`,
+			result: pgtype.TSVector{
+				Lexemes: []pgtype.TSLexeme{
+					{Lexeme: "fat", Positions: []pgtype.TSVectorPosition{{Position: 1, Weight: pgtype.TSWeightC}, {Position: 2, Weight: pgtype.TSWeightA}}},
+					{Lexeme: "rat"},
+				},
+				Status: pgtype.Present,
+			},
+		},
+		{
+			src: `'don''t' 'a\\b' c\ d:99999`,
+			result: pgtype.TSVector{
+				Lexemes: []pgtype.TSLexeme{
+					{Lexeme: `a\b`},
+					{Lexeme: "c d", Positions: []pgtype.TSVectorPosition{{Position: 16383, Weight: pgtype.TSWeightD}}},
+					{Lexeme: "don't"},
+				},
+				Status: pgtype.Present,
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		var r pgtype.TSVector
+		err := r.DecodeText(nil, []byte(tt.src))
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	for i, src := range []string{`'unterminated`, `a:`, `a:0`, `a:1X`, `a:x`} {
+		var r pgtype.TSVector
+		err := r.DecodeText(nil, []byte(src))
+		require.Errorf(t, err, "%d: %s", i, src)
+	}
+}
+
+func TestTSVectorEncodeText(t *testing.T) {
+	v := pgtype.TSVector{
+		Lexemes: []pgtype.TSLexeme{
+			{Lexeme: "a", Positions: []pgtype.TSVectorPosition{{Position: 1, Weight: pgtype.TSWeightA}, {Position: 2, Weight: pgtype.TSWeightD}}},
+			{Lexeme: `don't\`, Positions: []pgtype.TSVectorPosition{{Position: 3, Weight: pgtype.TSWeightC}}},
+		},
+		Status: pgtype.Present,
+	}
+
+	buf, err := v.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `'a':1A,2 'don''t\\':3C`, string(buf))
+
+	buf, err = pgtype.TSVector{Status: pgtype.Present}.EncodeText(nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, buf)
+	require.Len(t, buf, 0)
+}
+
+func TestTSVectorBinaryRoundTrip(t *testing.T) {
+	var v pgtype.TSVector
+	err := v.Set(`'a':1A,2 'cat':3B,16383 rat`)
+	require.NoError(t, err)
+
+	buf, err := v.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+
+	var r pgtype.TSVector
+	err = r.DecodeBinary(nil, buf)
+	require.NoError(t, err)
+	require.Equal(t, v, r)
+
+	err = r.DecodeBinary(nil, []byte{0x7f, 0xff, 0xff, 0xff, 'a', 0, 0, 0})
+	require.EqualError(t, err, "invalid tsvector: too few bytes for 2147483647 lexemes")
+
+	_, err = pgtype.TSVector{Lexemes: []pgtype.TSLexeme{{Lexeme: "a", Positions: []pgtype.TSVectorPosition{{Position: 0}}}}, Status: pgtype.Present}.EncodeBinary(nil, nil)
+	require.Error(t, err)
+}
+
+func TestTSVectorAssignTo(t *testing.T) {
+	var v pgtype.TSVector
+	err := v.Set(`'a':1A 'b'`)
+	require.NoError(t, err)
+
+	var s string
+	err = v.AssignTo(&s)
+	require.NoError(t, err)
+	require.Equal(t, `'a':1A 'b'`, s)
+
+	var lexemes []pgtype.TSLexeme
+	err = v.AssignTo(&lexemes)
+	require.NoError(t, err)
+	require.Equal(t, v.Lexemes, lexemes)
+
+	var ps *string
+	null := pgtype.TSVector{Status: pgtype.Null}
+	err = null.AssignTo(&ps)
+	require.NoError(t, err)
+	require.Nil(t, ps)
+}
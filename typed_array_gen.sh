@@ -1,28 +1,80 @@
-erb pgtype_array_type=Int2Array pgtype_element_type=Int2 go_array_types=[]int16,[]*int16,[]uint16,[]*uint16,[]int32,[]*int32,[]uint32,[]*uint32,[]int64,[]*int64,[]uint64,[]*uint64,[]int,[]*int,[]uint,[]*uint element_type_name=int2 text_null=NULL binary_format=true typed_array.go.erb > int2_array.go
-erb pgtype_array_type=Int4Array pgtype_element_type=Int4 go_array_types=[]int16,[]*int16,[]uint16,[]*uint16,[]int32,[]*int32,[]uint32,[]*uint32,[]int64,[]*int64,[]uint64,[]*uint64,[]int,[]*int,[]uint,[]*uint element_type_name=int4 text_null=NULL binary_format=true typed_array.go.erb > int4_array.go
-erb pgtype_array_type=Int8Array pgtype_element_type=Int8 go_array_types=[]int16,[]*int16,[]uint16,[]*uint16,[]int32,[]*int32,[]uint32,[]*uint32,[]int64,[]*int64,[]uint64,[]*uint64,[]int,[]*int,[]uint,[]*uint element_type_name=int8 text_null=NULL binary_format=true typed_array.go.erb > int8_array.go
-erb pgtype_array_type=BoolArray pgtype_element_type=Bool go_array_types=[]bool,[]*bool element_type_name=bool text_null=NULL binary_format=true typed_array.go.erb > bool_array.go
-erb pgtype_array_type=DateArray pgtype_element_type=Date go_array_types=[]time.Time,[]*time.Time element_type_name=date text_null=NULL binary_format=true typed_array.go.erb > date_array.go
-erb pgtype_array_type=TimestamptzArray pgtype_element_type=Timestamptz go_array_types=[]time.Time,[]*time.Time element_type_name=timestamptz text_null=NULL binary_format=true typed_array.go.erb > timestamptz_array.go
-erb pgtype_array_type=TstzrangeArray pgtype_element_type=Tstzrange go_array_types=[]Tstzrange element_type_name=tstzrange text_null=NULL binary_format=true typed_array.go.erb > tstzrange_array.go
-erb pgtype_array_type=TsrangeArray pgtype_element_type=Tsrange go_array_types=[]Tsrange element_type_name=tsrange text_null=NULL binary_format=true typed_array.go.erb > tsrange_array.go
-erb pgtype_array_type=TimestampArray pgtype_element_type=Timestamp go_array_types=[]time.Time,[]*time.Time element_type_name=timestamp text_null=NULL binary_format=true typed_array.go.erb > timestamp_array.go
-erb pgtype_array_type=Float4Array pgtype_element_type=Float4 go_array_types=[]float32,[]*float32 element_type_name=float4 text_null=NULL binary_format=true typed_array.go.erb > float4_array.go
-erb pgtype_array_type=Float8Array pgtype_element_type=Float8 go_array_types=[]float64,[]*float64 element_type_name=float8 text_null=NULL binary_format=true typed_array.go.erb > float8_array.go
-erb pgtype_array_type=InetArray pgtype_element_type=Inet go_array_types=[]*net.IPNet,[]net.IP,[]*net.IP element_type_name=inet text_null=NULL binary_format=true typed_array.go.erb > inet_array.go
-erb pgtype_array_type=MacaddrArray pgtype_element_type=Macaddr go_array_types=[]net.HardwareAddr,[]*net.HardwareAddr element_type_name=macaddr text_null=NULL binary_format=true typed_array.go.erb > macaddr_array.go
-erb pgtype_array_type=CIDRArray pgtype_element_type=CIDR go_array_types=[]*net.IPNet,[]net.IP,[]*net.IP element_type_name=cidr text_null=NULL binary_format=true typed_array.go.erb > cidr_array.go
-erb pgtype_array_type=TextArray pgtype_element_type=Text go_array_types=[]string,[]*string element_type_name=text text_null=NULL binary_format=true typed_array.go.erb > text_array.go
-erb pgtype_array_type=VarcharArray pgtype_element_type=Varchar go_array_types=[]string,[]*string element_type_name=varchar text_null=NULL binary_format=true typed_array.go.erb > varchar_array.go
-erb pgtype_array_type=BPCharArray pgtype_element_type=BPChar go_array_types=[]string,[]*string element_type_name=bpchar text_null=NULL binary_format=true typed_array.go.erb > bpchar_array.go
-erb pgtype_array_type=ByteaArray pgtype_element_type=Bytea go_array_types=[][]byte element_type_name=bytea text_null=NULL binary_format=true typed_array.go.erb > bytea_array.go
-erb pgtype_array_type=ACLItemArray pgtype_element_type=ACLItem go_array_types=[]string,[]*string element_type_name=aclitem text_null=NULL binary_format=false typed_array.go.erb > aclitem_array.go
-erb pgtype_array_type=HstoreArray pgtype_element_type=Hstore go_array_types=[]map[string]string element_type_name=hstore text_null=NULL binary_format=true typed_array.go.erb > hstore_array.go
-erb pgtype_array_type=NumericArray pgtype_element_type=Numeric go_array_types=[]float32,[]*float32,[]float64,[]*float64,[]int64,[]*int64,[]uint64,[]*uint64 element_type_name=numeric text_null=NULL binary_format=true typed_array.go.erb > numeric_array.go
-erb pgtype_array_type=UUIDArray pgtype_element_type=UUID go_array_types=[][16]byte,[][]byte,[]string,[]*string element_type_name=uuid text_null=NULL binary_format=true typed_array.go.erb > uuid_array.go
-erb pgtype_array_type=JSONBArray pgtype_element_type=JSONB go_array_types=[]string,[][]byte element_type_name=jsonb text_null=NULL binary_format=true typed_array.go.erb > jsonb_array.go
+erb pgtype_array_type=Int2Array pgtype_element_type=Int2 go_array_types=[]int16,[]*int16,[]uint16,[]*uint16,[]int32,[]*int32,[]uint32,[]*uint32,[]int64,[]*int64,[]uint64,[]*uint64,[]int,[]*int,[]uint,[]*uint element_type_name=int2 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int2_array.go
+erb pgtype_array_type=Int4Array pgtype_element_type=Int4 go_array_types=[]int16,[]*int16,[]uint16,[]*uint16,[]int32,[]*int32,[]uint32,[]*uint32,[]int64,[]*int64,[]uint64,[]*uint64,[]int,[]*int,[]uint,[]*uint element_type_name=int4 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int4_array.go
+erb pgtype_array_type=Int8Array pgtype_element_type=Int8 go_array_types=[]int16,[]*int16,[]uint16,[]*uint16,[]int32,[]*int32,[]uint32,[]*uint32,[]int64,[]*int64,[]uint64,[]*uint64,[]int,[]*int,[]uint,[]*uint element_type_name=int8 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int8_array.go
+erb pgtype_array_type=BoolArray pgtype_element_type=Bool go_array_types=[]bool,[]*bool element_type_name=bool text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > bool_array.go
+erb pgtype_array_type=DateArray pgtype_element_type=Date go_array_types=[]time.Time,[]*time.Time element_type_name=date text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > date_array.go
+erb pgtype_array_type=TimestamptzArray pgtype_element_type=Timestamptz go_array_types=[]time.Time,[]*time.Time element_type_name=timestamptz text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > timestamptz_array.go
+erb pgtype_array_type=TstzrangeArray pgtype_element_type=Tstzrange go_array_types=[]Tstzrange element_type_name=tstzrange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tstzrange_array.go
+erb pgtype_array_type=TsrangeArray pgtype_element_type=Tsrange go_array_types=[]Tsrange element_type_name=tsrange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tsrange_array.go
+erb pgtype_array_type=TimestampArray pgtype_element_type=Timestamp go_array_types=[]time.Time,[]*time.Time element_type_name=timestamp text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > timestamp_array.go
+erb pgtype_array_type=Float4Array pgtype_element_type=Float4 go_array_types=[]float32,[]*float32 element_type_name=float4 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > float4_array.go
+erb pgtype_array_type=Float8Array pgtype_element_type=Float8 go_array_types=[]float64,[]*float64 element_type_name=float8 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > float8_array.go
+erb pgtype_array_type=InetArray pgtype_element_type=Inet go_array_types=[]*net.IPNet,[]net.IP,[]*net.IP element_type_name=inet text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > inet_array.go
+erb pgtype_array_type=MacaddrArray pgtype_element_type=Macaddr go_array_types=[]net.HardwareAddr,[]*net.HardwareAddr element_type_name=macaddr text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > macaddr_array.go
+erb pgtype_array_type=Macaddr8Array pgtype_element_type=Macaddr8 go_array_types=[]net.HardwareAddr,[]*net.HardwareAddr element_type_name=macaddr8 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > macaddr8_array.go
+erb pgtype_array_type=CIDRArray pgtype_element_type=CIDR go_array_types=[]*net.IPNet,[]net.IP,[]*net.IP element_type_name=cidr text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > cidr_array.go
+erb pgtype_array_type=TextArray pgtype_element_type=Text go_array_types=[]string,[]*string element_type_name=text text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > text_array.go
+erb pgtype_array_type=VarcharArray pgtype_element_type=Varchar go_array_types=[]string,[]*string element_type_name=varchar text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > varchar_array.go
+erb pgtype_array_type=BPCharArray pgtype_element_type=BPChar go_array_types=[]string,[]*string element_type_name=bpchar text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > bpchar_array.go
+erb pgtype_array_type=ByteaArray pgtype_element_type=Bytea go_array_types=[][]byte element_type_name=bytea text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > bytea_array.go
+erb pgtype_array_type=ACLItemArray pgtype_element_type=ACLItem go_array_types=[]string,[]*string element_type_name=aclitem text_null=NULL text_delimiter=, text_format=true binary_format=false typed_array.go.erb > aclitem_array.go
+erb pgtype_array_type=HstoreArray pgtype_element_type=Hstore go_array_types=[]map[string]string element_type_name=hstore text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > hstore_array.go
+erb pgtype_array_type=NumericArray pgtype_element_type=Numeric go_array_types=[]float32,[]*float32,[]float64,[]*float64,[]int64,[]*int64,[]uint64,[]*uint64 element_type_name=numeric text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > numeric_array.go
+erb pgtype_array_type=UUIDArray pgtype_element_type=UUID go_array_types=[][16]byte,[][]byte,[]string,[]*string element_type_name=uuid text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > uuid_array.go
+erb pgtype_array_type=JSONBArray pgtype_element_type=JSONB go_array_types=[]string,[][]byte element_type_name=jsonb text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > jsonb_array.go
+erb pgtype_array_type=TSVectorArray pgtype_element_type=TSVector go_array_types=[]string,[]*string element_type_name=tsvector text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tsvector_array.go
+erb pgtype_array_type=TSQueryArray pgtype_element_type=TSQuery go_array_types=[]string,[]*string element_type_name=tsquery text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tsquery_array.go
+erb pgtype_array_type=MoneyArray pgtype_element_type=Money go_array_types=[]int64,[]*int64,[]string,[]*string element_type_name=money text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > money_array.go
+erb pgtype_array_type=TimetzArray pgtype_element_type=Timetz go_array_types=[]time.Time,[]*time.Time element_type_name=timetz text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > timetz_array.go
+erb pgtype_array_type=LSNArray pgtype_element_type=LSN go_array_types=[]uint64,[]*uint64,[]string,[]*string element_type_name=pg_lsn text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > lsn_array.go
+erb pgtype_array_type=XMLArray pgtype_element_type=XML go_array_types=[]string,[]*string,[][]byte element_type_name=xml text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > xml_array.go
+erb pgtype_array_type=PointArray pgtype_element_type=Point go_array_types=[]Point element_type_name=point text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > point_array.go
+erb pgtype_array_type=LsegArray pgtype_element_type=Lseg go_array_types=[]Lseg element_type_name=lseg text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > lseg_array.go
+erb pgtype_array_type=PathArray pgtype_element_type=Path go_array_types=[]Path element_type_name=path text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > path_array.go
+erb pgtype_array_type=BoxArray pgtype_element_type=Box go_array_types=[]Box element_type_name=box text_null=NULL 'text_delimiter=;' text_format=true binary_format=true typed_array.go.erb > box_array.go
+erb pgtype_array_type=PolygonArray pgtype_element_type=Polygon go_array_types=[]Polygon element_type_name=polygon text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > polygon_array.go
+erb pgtype_array_type=LineArray pgtype_element_type=Line go_array_types=[]Line element_type_name=line text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > line_array.go
+erb pgtype_array_type=CircleArray pgtype_element_type=Circle go_array_types=[]Circle element_type_name=circle text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > circle_array.go
+
+erb pgtype_array_type=IntervalArray pgtype_element_type=Interval go_array_types=[]time.Duration,[]*time.Duration element_type_name=interval text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > interval_array.go
+erb pgtype_array_type=TimeArray pgtype_element_type=Time go_array_types=[]time.Time,[]*time.Time element_type_name=time text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > time_array.go
+erb pgtype_array_type=Int4rangeArray pgtype_element_type=Int4range go_array_types=[]Int4range element_type_name=int4range text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int4range_array.go
+erb pgtype_array_type=Int8rangeArray pgtype_element_type=Int8range go_array_types=[]Int8range element_type_name=int8range text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int8range_array.go
+erb pgtype_array_type=NumrangeArray pgtype_element_type=Numrange go_array_types=[]Numrange element_type_name=numrange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > numrange_array.go
+erb pgtype_array_type=DaterangeArray pgtype_element_type=Daterange go_array_types=[]Daterange element_type_name=daterange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > daterange_array.go
+erb pgtype_array_type=Int4multirangeArray pgtype_element_type=Int4multirange go_array_types=[][]Int4range element_type_name=int4multirange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int4multirange_array.go
+erb pgtype_array_type=Int8multirangeArray pgtype_element_type=Int8multirange go_array_types=[][]Int8range element_type_name=int8multirange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int8multirange_array.go
+erb pgtype_array_type=NummultirangeArray pgtype_element_type=Nummultirange go_array_types=[][]Numrange element_type_name=nummultirange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > nummultirange_array.go
+erb pgtype_array_type=DatemultirangeArray pgtype_element_type=Datemultirange go_array_types=[][]Daterange element_type_name=datemultirange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > datemultirange_array.go
+erb pgtype_array_type=TsmultirangeArray pgtype_element_type=Tsmultirange go_array_types=[][]Tsrange element_type_name=tsmultirange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tsmultirange_array.go
+erb pgtype_array_type=TstzmultirangeArray pgtype_element_type=Tstzmultirange go_array_types=[][]Tstzrange element_type_name=tstzmultirange text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tstzmultirange_array.go
+erb pgtype_array_type=JSONArray pgtype_element_type=JSON go_array_types=[]string,[][]byte element_type_name=json text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > json_array.go
+erb pgtype_array_type=JSONPathArray pgtype_element_type=JSONPath go_array_types=[]string,[]*string element_type_name=jsonpath text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > jsonpath_array.go
+erb pgtype_array_type=NameArray pgtype_element_type=Name go_array_types=[]string,[]*string element_type_name=name text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > name_array.go
+erb pgtype_array_type=QCharArray pgtype_element_type=QChar go_array_types=[]int8,[]*int8 element_type_name=char text_null=NULL text_delimiter=, text_format=false binary_format=true typed_array.go.erb > qchar_array.go
+erb pgtype_array_type=OIDValueArray pgtype_element_type=OIDValue go_array_types=[]uint32 element_type_name=oid text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > oid_value_array.go
+erb pgtype_array_type=XIDArray pgtype_element_type=XID go_array_types=[]uint32 element_type_name=xid text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > xid_array.go
+erb pgtype_array_type=XID8Array pgtype_element_type=XID8 go_array_types=[]uint64 element_type_name=xid8 text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > xid8_array.go
+erb pgtype_array_type=CIDArray pgtype_element_type=CID go_array_types=[]uint32 element_type_name=cid text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > cid_array.go
+erb pgtype_array_type=TIDArray pgtype_element_type=TID go_array_types=[]string element_type_name=tid text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > tid_array.go
+erb pgtype_array_type=BitArray pgtype_element_type=Bit go_array_types=[]Bit element_type_name=bit text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > bit_array.go
+erb pgtype_array_type=VarbitArray pgtype_element_type=Varbit go_array_types=[]Varbit element_type_name=varbit text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > varbit_array.go
+erb pgtype_array_type=SnapshotArray pgtype_element_type=Snapshot go_array_types=[]string,[]*string element_type_name=pg_snapshot text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > snapshot_array.go
+erb pgtype_array_type=Int2VectorArray pgtype_element_type=Int2Vector go_array_types=[][]int16 element_type_name=int2vector text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > int2vector_array.go
+erb pgtype_array_type=OIDVectorArray pgtype_element_type=OIDVector go_array_types=[][]uint32 element_type_name=oidvector text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > oidvector_array.go
+erb pgtype_array_type=RegclassArray pgtype_element_type=Regclass go_array_types=[]uint32,[]string element_type_name=regclass text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regclass_array.go
+erb pgtype_array_type=RegcollationArray pgtype_element_type=Regcollation go_array_types=[]uint32,[]string element_type_name=regcollation text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regcollation_array.go
+erb pgtype_array_type=RegconfigArray pgtype_element_type=Regconfig go_array_types=[]uint32,[]string element_type_name=regconfig text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regconfig_array.go
+erb pgtype_array_type=RegdictionaryArray pgtype_element_type=Regdictionary go_array_types=[]uint32,[]string element_type_name=regdictionary text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regdictionary_array.go
+erb pgtype_array_type=RegnamespaceArray pgtype_element_type=Regnamespace go_array_types=[]uint32,[]string element_type_name=regnamespace text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regnamespace_array.go
+erb pgtype_array_type=RegoperArray pgtype_element_type=Regoper go_array_types=[]uint32,[]string element_type_name=regoper text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regoper_array.go
+erb pgtype_array_type=RegoperatorArray pgtype_element_type=Regoperator go_array_types=[]uint32,[]string element_type_name=regoperator text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regoperator_array.go
+erb pgtype_array_type=RegprocArray pgtype_element_type=Regproc go_array_types=[]uint32,[]string element_type_name=regproc text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regproc_array.go
+erb pgtype_array_type=RegprocedureArray pgtype_element_type=Regprocedure go_array_types=[]uint32,[]string element_type_name=regprocedure text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regprocedure_array.go
+erb pgtype_array_type=RegroleArray pgtype_element_type=Regrole go_array_types=[]uint32,[]string element_type_name=regrole text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regrole_array.go
+erb pgtype_array_type=RegtypeArray pgtype_element_type=Regtype go_array_types=[]uint32,[]string element_type_name=regtype text_null=NULL text_delimiter=, text_format=true binary_format=true typed_array.go.erb > regtype_array.go
 
 # While the binary format is theoretically possible it is only practical to use the text format.
-erb pgtype_array_type=EnumArray pgtype_element_type=GenericText go_array_types=[]string,[]*string text_null=NULL binary_format=false typed_array.go.erb > enum_array.go
+erb pgtype_array_type=EnumArray pgtype_element_type=GenericText go_array_types=[]string,[]*string text_null=NULL text_delimiter=, text_format=true binary_format=false typed_array.go.erb > enum_array.go
 
 goimports -w *_array.go
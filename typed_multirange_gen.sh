@@ -0,0 +1,7 @@
+erb range_type=Int4range multirange_type=Int4multirange typed_multirange.go.erb > int4multirange.go
+erb range_type=Int8range multirange_type=Int8multirange typed_multirange.go.erb > int8multirange.go
+erb range_type=Numrange multirange_type=Nummultirange typed_multirange.go.erb > nummultirange.go
+erb range_type=Daterange multirange_type=Datemultirange typed_multirange.go.erb > datemultirange.go
+erb range_type=Tsrange multirange_type=Tsmultirange typed_multirange.go.erb > tsmultirange.go
+erb range_type=Tstzrange multirange_type=Tstzmultirange typed_multirange.go.erb > tstzmultirange.go
+goimports -w *multirange.go
@@ -0,0 +1,12 @@
+erb pgtype_type=Regclass pg_type=regclass 'object=relations' resolve_type_names=false typed_reg_oid.go.erb > regclass.go
+erb pgtype_type=Regcollation pg_type=regcollation 'object=collations' resolve_type_names=false typed_reg_oid.go.erb > regcollation.go
+erb pgtype_type=Regconfig pg_type=regconfig 'object=text search configurations' resolve_type_names=false typed_reg_oid.go.erb > regconfig.go
+erb pgtype_type=Regdictionary pg_type=regdictionary 'object=text search dictionaries' resolve_type_names=false typed_reg_oid.go.erb > regdictionary.go
+erb pgtype_type=Regnamespace pg_type=regnamespace 'object=schemas' resolve_type_names=false typed_reg_oid.go.erb > regnamespace.go
+erb pgtype_type=Regoper pg_type=regoper 'object=operators' resolve_type_names=false typed_reg_oid.go.erb > regoper.go
+erb pgtype_type=Regoperator pg_type=regoperator 'object=operators with argument types' resolve_type_names=false typed_reg_oid.go.erb > regoperator.go
+erb pgtype_type=Regproc pg_type=regproc 'object=functions' resolve_type_names=false typed_reg_oid.go.erb > regproc.go
+erb pgtype_type=Regprocedure pg_type=regprocedure 'object=functions with argument types' resolve_type_names=false typed_reg_oid.go.erb > regprocedure.go
+erb pgtype_type=Regrole pg_type=regrole 'object=roles' resolve_type_names=false typed_reg_oid.go.erb > regrole.go
+erb pgtype_type=Regtype pg_type=regtype 'object=data types' resolve_type_names=true typed_reg_oid.go.erb > regtype.go
+goimports -w reg*.go
@@ -0,0 +1,29 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestVarbitArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "varbit[]", []interface{}{
+		&pgtype.VarbitArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.VarbitArray{
+			Elements: []pgtype.Varbit{
+				{Bytes: []byte{}, Len: 0, Status: pgtype.Present},
+				{Bytes: []byte{0, 1, 128, 254, 255}, Len: 40, Status: pgtype.Present},
+				{Bytes: []byte{0, 1, 128, 254, 128}, Len: 33, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 4, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.VarbitArray{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,28 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+)
+
+func TestXID8ArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "xid8[]", []interface{}{
+		&pgtype.XID8Array{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.XID8Array{
+			Elements: []pgtype.XID8{
+				{Uint: 42, Status: pgtype.Present},
+				{Uint: 18446744073709551615, Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 3, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.XID8Array{Status: pgtype.Null},
+	})
+}
@@ -0,0 +1,64 @@
+package pgtype_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXID8Transcode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "xid8", []interface{}{
+		&pgtype.XID8{Uint: 42, Status: pgtype.Present},
+		&pgtype.XID8{Uint: math.MaxUint64, Status: pgtype.Present},
+		&pgtype.XID8{Status: pgtype.Null},
+	})
+}
+
+func TestXID8Set(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.XID8
+	}{
+		{source: uint64(1), result: pgtype.XID8{Uint: 1, Status: pgtype.Present}},
+		{source: int64(1), result: pgtype.XID8{Uint: 1, Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var r pgtype.XID8
+		err := r.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, r, "%d", i)
+	}
+
+	var r pgtype.XID8
+	require.Error(t, r.Set(int64(-1)))
+}
+
+func TestXID8AssignTo(t *testing.T) {
+	var ui64 uint64
+	var pui64 *uint64
+
+	src := pgtype.XID8{Uint: 42, Status: pgtype.Present}
+	require.NoError(t, src.AssignTo(&ui64))
+	require.Equal(t, uint64(42), ui64)
+	require.NoError(t, src.AssignTo(&pui64))
+	require.Equal(t, uint64(42), *pui64)
+
+	null := pgtype.XID8{Status: pgtype.Null}
+	require.NoError(t, null.AssignTo(&pui64))
+	require.Nil(t, pui64)
+	require.Error(t, null.AssignTo(&ui64))
+}
+
+func TestXID8Value(t *testing.T) {
+	v, err := pgtype.XID8{Uint: 42, Status: pgtype.Present}.Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(42), v)
+
+	v, err = pgtype.XID8{Uint: math.MaxUint64, Status: pgtype.Present}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "18446744073709551615", v)
+}
@@ -0,0 +1,182 @@
+package pgtype
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+)
+
+// XML represents a PostgreSQL xml value. Like JSON, Set falls back to encoding/xml to marshal values and AssignTo
+// falls back to encoding/xml to unmarshal into values. The server checks that the value is well formed.
+type XML struct {
+	Bytes  []byte
+	Status Status
+}
+
+func (dst *XML) Set(src interface{}) error {
+	if src == nil {
+		*dst = XML{Status: Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case XML:
+		*dst = value
+		return nil
+	case *XML:
+		if value == nil {
+			*dst = XML{Status: Null}
+		} else {
+			*dst = *value
+		}
+		return nil
+	}
+
+	if value, ok := src.(interface{ Get() interface{} }); ok {
+		value2 := value.Get()
+		if value2 != value {
+			return dst.Set(value2)
+		}
+	}
+
+	switch value := src.(type) {
+	case string:
+		*dst = XML{Bytes: []byte(value), Status: Present}
+	case *string:
+		if value == nil {
+			*dst = XML{Status: Null}
+		} else {
+			*dst = XML{Bytes: []byte(*value), Status: Present}
+		}
+	case []byte:
+		if value == nil {
+			*dst = XML{Status: Null}
+		} else {
+			*dst = XML{Bytes: value, Status: Present}
+		}
+	default:
+		buf, err := xml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		*dst = XML{Bytes: buf, Status: Present}
+	}
+
+	return nil
+}
+
+func (dst XML) Get() interface{} {
+	switch dst.Status {
+	case Present:
+		return dst
+	case Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *XML) AssignTo(dst interface{}) error {
+	switch v := dst.(type) {
+	case *string:
+		if src.Status == Present {
+			*v = string(src.Bytes)
+		} else {
+			return fmt.Errorf("cannot assign non-present status to %T", dst)
+		}
+	case **string:
+		if src.Status == Present {
+			s := string(src.Bytes)
+			*v = &s
+		} else {
+			*v = nil
+		}
+	case *[]byte:
+		if src.Status != Present {
+			*v = nil
+		} else {
+			buf := make([]byte, len(src.Bytes))
+			copy(buf, src.Bytes)
+			*v = buf
+		}
+	default:
+		switch src.Status {
+		case Present:
+			return xml.Unmarshal(src.Bytes, dst)
+		case Null:
+			return NullAssignTo(dst)
+		}
+		return fmt.Errorf("cannot decode %#v into %T", src, dst)
+	}
+
+	return nil
+}
+
+func (XML) PreferredResultFormat() int16 {
+	return TextFormatCode
+}
+
+func (dst *XML) DecodeText(ci *ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = XML{Status: Null}
+		return nil
+	}
+
+	*dst = XML{Bytes: src, Status: Present}
+	return nil
+}
+
+func (dst *XML) DecodeBinary(ci *ConnInfo, src []byte) error {
+	return dst.DecodeText(ci, src)
+}
+
+func (XML) PreferredParamFormat() int16 {
+	return TextFormatCode
+}
+
+func (src XML) EncodeText(ci *ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case Null:
+		return nil, nil
+	case Undefined:
+		return nil, errUndefined
+	}
+
+	return append(buf, src.Bytes...), nil
+}
+
+func (src XML) EncodeBinary(ci *ConnInfo, buf []byte) ([]byte, error) {
+	return src.EncodeText(ci, buf)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *XML) Scan(src interface{}) error {
+	if src == nil {
+		*dst = XML{Status: Null}
+		return nil
+	}
+
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface. The value is returned as a string as a []byte would be
+// sent as bytea by some drivers.
+func (src XML) Value() (driver.Value, error) {
+	switch src.Status {
+	case Present:
+		return string(src.Bytes), nil
+	case Null:
+		return nil, nil
+	default:
+		return nil, errUndefined
+	}
+}
@@ -0,0 +1,45 @@
+package pgtype_test
+
+import (
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXMLArrayTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "xml[]", []interface{}{
+		&pgtype.XMLArray{
+			Elements:   nil,
+			Dimensions: nil,
+			Status:     pgtype.Present,
+		},
+		&pgtype.XMLArray{
+			Elements: []pgtype.XML{
+				{Bytes: []byte("<foo>bar</foo>"), Status: pgtype.Present},
+				{Status: pgtype.Null},
+			},
+			Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+			Status:     pgtype.Present,
+		},
+		&pgtype.XMLArray{Status: pgtype.Null},
+	})
+}
+
+func TestXMLArraySetAndAssignTo(t *testing.T) {
+	var src pgtype.XMLArray
+	require.NoError(t, src.Set([]string{"<a/>", "<b/>"}))
+	require.Equal(t, pgtype.XMLArray{
+		Elements: []pgtype.XML{
+			{Bytes: []byte("<a/>"), Status: pgtype.Present},
+			{Bytes: []byte("<b/>"), Status: pgtype.Present},
+		},
+		Dimensions: []pgtype.ArrayDimension{{Length: 2, LowerBound: 1}},
+		Status:     pgtype.Present,
+	}, src)
+
+	var dst []string
+	require.NoError(t, src.AssignTo(&dst))
+	require.Equal(t, []string{"<a/>", "<b/>"}, dst)
+}
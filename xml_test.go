@@ -0,0 +1,87 @@
+package pgtype_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/matthewpi/pgtype"
+	"github.com/matthewpi/pgtype/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type xmlPerson struct {
+	XMLName xml.Name `xml:"person"`
+	Name    string   `xml:"name"`
+	Age     int      `xml:"age,attr"`
+}
+
+type xmlUpper string
+
+func (s xmlUpper) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(string(s)+"!", xml.StartElement{Name: xml.Name{Local: "shout"}})
+}
+
+func TestXMLTranscode(t *testing.T) {
+	testutil.TestSuccessfulTranscode(t, "xml", []interface{}{
+		&pgtype.XML{Bytes: []byte("<foo>bar</foo>"), Status: pgtype.Present},
+		&pgtype.XML{Bytes: []byte("text content"), Status: pgtype.Present},
+		&pgtype.XML{Bytes: []byte(""), Status: pgtype.Present},
+		&pgtype.XML{Status: pgtype.Null},
+	})
+}
+
+func TestXMLSet(t *testing.T) {
+	successfulTests := []struct {
+		source interface{}
+		result pgtype.XML
+	}{
+		{source: "<foo/>", result: pgtype.XML{Bytes: []byte("<foo/>"), Status: pgtype.Present}},
+		{source: []byte("<foo/>"), result: pgtype.XML{Bytes: []byte("<foo/>"), Status: pgtype.Present}},
+		{source: ([]byte)(nil), result: pgtype.XML{Status: pgtype.Null}},
+		{source: (*string)(nil), result: pgtype.XML{Status: pgtype.Null}},
+		{source: pgtype.XML{Bytes: []byte("<foo/>"), Status: pgtype.Present}, result: pgtype.XML{Bytes: []byte("<foo/>"), Status: pgtype.Present}},
+		{source: &pgtype.Text{String: "<foo/>", Status: pgtype.Present}, result: pgtype.XML{Bytes: []byte("<foo/>"), Status: pgtype.Present}},
+		{source: xmlPerson{Name: "John", Age: 42}, result: pgtype.XML{Bytes: []byte(`<person age="42"><name>John</name></person>`), Status: pgtype.Present}},
+		{source: xmlUpper("hi"), result: pgtype.XML{Bytes: []byte(`<shout>hi!</shout>`), Status: pgtype.Present}},
+	}
+
+	for i, tt := range successfulTests {
+		var d pgtype.XML
+		err := d.Set(tt.source)
+		require.NoErrorf(t, err, "%d", i)
+		require.Equalf(t, tt.result, d, "%d", i)
+	}
+
+	var d pgtype.XML
+	require.Error(t, d.Set(make(chan int)))
+}
+
+func TestXMLAssignTo(t *testing.T) {
+	src := pgtype.XML{Bytes: []byte(`<person age="42"><name>John</name></person>`), Status: pgtype.Present}
+
+	var s string
+	require.NoError(t, src.AssignTo(&s))
+	require.Equal(t, `<person age="42"><name>John</name></person>`, s)
+
+	var b []byte
+	require.NoError(t, src.AssignTo(&b))
+	require.Equal(t, []byte(`<person age="42"><name>John</name></person>`), b)
+
+	var person xmlPerson
+	require.NoError(t, src.AssignTo(&person))
+	require.Equal(t, "John", person.Name)
+	require.Equal(t, 42, person.Age)
+
+	null := pgtype.XML{Status: pgtype.Null}
+
+	var ps *string
+	require.NoError(t, null.AssignTo(&ps))
+	require.Nil(t, ps)
+
+	var pPerson *xmlPerson
+	require.NoError(t, null.AssignTo(&pPerson))
+	require.Nil(t, pPerson)
+
+	require.Error(t, null.AssignTo(&s))
+	require.Error(t, null.AssignTo(&person))
+}